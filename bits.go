@@ -0,0 +1,92 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "errors"
+
+// BytesWithPopcount is a function that returns a slice of n bytes containing
+// exactly the number of set bits specified by ones. The set bits are placed at
+// uniformly random positions within the slice. The value of ones must be
+// within the range [0, 8n].
+func BytesWithPopcount(n, ones int) ([]byte, error) {
+	if n < 0 {
+		return nil, errors.New("n must not be negative")
+	}
+
+	if ones < 0 || ones > 8*n {
+		return nil, errors.New("ones must be in the range [0, 8n]")
+	}
+
+	positions, err := sampleIndices(8*n, ones)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, n)
+
+	for _, pos := range positions {
+		b[pos/8] |= 1 << uint(pos%8)
+	}
+
+	return b, nil
+}
+
+// RandomFlags is a function that returns a random value whose set bits are a
+// subset of the bits set in max. This is useful for fuzzing bitflag-based APIs,
+// as only the defined flags can appear in the result. Each bit of max is set in
+// the result with a probability of one half.
+func RandomFlags(max uint64) (uint64, error) {
+	u64, err := Uint64()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return u64 & max, nil
+}
+
+// BytesExcluding is a function that returns a slice of n random bytes, none of
+// which appear in exclude. This is useful for avoiding sentinel bytes, such as
+// newlines or NULs in a line-based protocol. Any excluded byte that's generated
+// is drawn again, so the remaining values stay uniformly distributed. An error
+// is returned if exclude covers all 256 byte values.
+func BytesExcluding(n int, exclude []byte) ([]byte, error) {
+	if n < 0 {
+		return nil, errors.New("n must not be negative")
+	}
+
+	var excluded [256]bool
+	var count int
+
+	for _, e := range exclude {
+		if !excluded[e] {
+			excluded[e] = true
+			count++
+		}
+	}
+
+	if count == 256 {
+		return nil, errors.New("exclude must not cover all byte values")
+	}
+
+	out := make([]byte, 0, n)
+
+	for len(out) < n {
+		b, err := Bytes(n - len(out))
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range b {
+			if !excluded[v] {
+				out = append(out, v)
+			}
+		}
+	}
+
+	return out, nil
+}
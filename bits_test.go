@@ -0,0 +1,142 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"math/bits"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// popcount is a function that returns the number of set bits in b.
+func popcount(b []byte) int {
+	var n int
+
+	for _, v := range b {
+		n += bits.OnesCount8(v)
+	}
+
+	return n
+}
+
+func (*TestSuite) TestBytesWithPopcount(c *C) {
+	var b []byte
+	var err error
+
+	_, err = securerandom.BytesWithPopcount(2, -1)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.BytesWithPopcount(2, 17)
+	c.Check(err, NotNil)
+
+	b, err = securerandom.BytesWithPopcount(4, 0)
+	c.Assert(err, IsNil)
+	c.Check(b, DeepEquals, []byte{0, 0, 0, 0})
+
+	b, err = securerandom.BytesWithPopcount(4, 32)
+	c.Assert(err, IsNil)
+	c.Check(b, DeepEquals, []byte{0xff, 0xff, 0xff, 0xff})
+
+	const runs = 4000
+	const ones = 3
+
+	var counts [16]int
+
+	for i := 0; i < runs; i++ {
+		b, err = securerandom.BytesWithPopcount(2, ones)
+		c.Assert(err, IsNil)
+		c.Assert(len(b), Equals, 2)
+		c.Assert(popcount(b), Equals, ones)
+
+		for pos := range counts {
+			if b[pos/8]&(1<<uint(pos%8)) != 0 {
+				counts[pos]++
+			}
+		}
+	}
+
+	// each position should be set roughly runs*ones/16 (750) times
+	for pos, n := range counts {
+		c.Check(n > 600 && n < 900, Equals, true, Commentf("position %d set %d times", pos, n))
+	}
+}
+
+func (*TestSuite) BenchmarkBytesWithPopcount(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.BytesWithPopcount(32, 128)
+		c.SetBytes(32)
+	}
+}
+
+func (*TestSuite) TestRandomFlags(c *C) {
+	var f uint64
+	var err error
+
+	f, err = securerandom.RandomFlags(0)
+	c.Assert(err, IsNil)
+	c.Check(f, Equals, uint64(0))
+
+	const max uint64 = 1<<0 | 1<<3 | 1<<17 | 1<<63
+
+	var union uint64
+
+	for i := 0; i < 200; i++ {
+		f, err = securerandom.RandomFlags(max)
+		c.Assert(err, IsNil)
+		c.Assert(f&^max, Equals, uint64(0))
+		union |= f
+	}
+
+	c.Check(union, Equals, max)
+}
+
+func (*TestSuite) BenchmarkRandomFlags(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomFlags(0xff)
+		c.SetBytes(8)
+	}
+}
+
+func (*TestSuite) TestBytesExcluding(c *C) {
+	var b []byte
+	var err error
+
+	all := make([]byte, 256)
+
+	for i := range all {
+		all[i] = byte(i)
+	}
+
+	_, err = securerandom.BytesExcluding(8, all)
+	c.Check(err, NotNil)
+
+	b, err = securerandom.BytesExcluding(64, nil)
+	c.Assert(err, IsNil)
+	c.Check(len(b), Equals, 64)
+
+	b, err = securerandom.BytesExcluding(4096, []byte{0x0a, 0x00, 0x0a})
+	c.Assert(err, IsNil)
+	c.Assert(len(b), Equals, 4096)
+
+	for _, v := range b {
+		c.Assert(v != 0x0a && v != 0x00, Equals, true)
+	}
+
+	// only a single value is allowed
+	b, err = securerandom.BytesExcluding(32, all[1:])
+	c.Assert(err, IsNil)
+	c.Check(b, DeepEquals, make([]byte, 32))
+}
+
+func (*TestSuite) BenchmarkBytesExcluding(c *C) {
+	exclude := []byte{0x00, 0x0a}
+
+	for i := 0; i < c.N; i++ {
+		securerandom.BytesExcluding(32, exclude)
+		c.SetBytes(32)
+	}
+}
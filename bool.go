@@ -0,0 +1,96 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"sync"
+)
+
+// BoolSource is a source of random bools that buffers a random byte and
+// dispenses its bits one at a time, only reading another byte once all eight
+// have been used. Generating many bools this way consumes one random byte per
+// eight bools, instead of one per bool as with Bool.
+//
+// The zero value is ready to use, and it's safe for concurrent use by multiple
+// goroutines.
+type BoolSource struct {
+	mu   sync.Mutex
+	buf  byte
+	bits uint
+}
+
+// Next is a method that returns the next random bool from the source.
+func (bs *BoolSource) Next() (bool, error) {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	if bs.bits == 0 {
+		b, err := Bytes(1)
+
+		if err != nil {
+			return false, err
+		}
+
+		bs.buf, bs.bits = b[0], 8
+	}
+
+	v := bs.buf&1 == 1
+	bs.buf >>= 1
+	bs.bits--
+
+	return v, nil
+}
+
+// FailureInjector is a biased coin for chaos testing, whose rate of failure
+// can be adjusted at runtime so tests can ramp failures up and down. It's safe
+// for concurrent use by multiple goroutines.
+//
+// Use NewFailureInjector to create one.
+type FailureInjector struct {
+	mu   sync.Mutex
+	rate float64
+}
+
+// NewFailureInjector is a function that returns a FailureInjector that fails
+// at the rate provided, which must be in the range [0, 1].
+func NewFailureInjector(initialRate float64) (*FailureInjector, error) {
+	fi := &FailureInjector{}
+
+	if err := fi.SetRate(initialRate); err != nil {
+		return nil, err
+	}
+
+	return fi, nil
+}
+
+// SetRate is a method that changes the failure rate, which must be in the
+// range [0, 1]. It takes effect on the next call to ShouldFail.
+func (fi *FailureInjector) SetRate(rate float64) error {
+	if !(rate >= 0 && rate <= 1) {
+		return errors.New("rate must be in the range [0, 1]")
+	}
+
+	fi.mu.Lock()
+	fi.rate = rate
+	fi.mu.Unlock()
+
+	return nil
+}
+
+// ShouldFail is a method that returns true with a probability equal to the
+// current failure rate.
+func (fi *FailureInjector) ShouldFail() (bool, error) {
+	f, err := float64n()
+
+	if err != nil {
+		return false, err
+	}
+
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+
+	return f < fi.rate, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestBool(c *C) {
+	var b bool
+	var err error
+
+	seen := make(map[bool]struct{})
+
+	for i := 0; i < 100; i++ {
+		b, err = securerandom.Bool()
+		c.Assert(err, IsNil)
+		seen[b] = struct{}{}
+	}
+
+	c.Check(len(seen), Equals, 2)
+}
+
+func (*TestSuite) BenchmarkBool(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.Bool()
+	}
+}
+
+func (*TestSuite) TestBoolSource(c *C) {
+	var bs securerandom.BoolSource
+	var b bool
+	var err error
+
+	const runs = 8000
+
+	// count the trues seen at each bit position within a byte, to make
+	// sure none of the buffered bits are biased
+	var counts [8]int
+
+	for i := 0; i < runs; i++ {
+		b, err = bs.Next()
+		c.Assert(err, IsNil)
+
+		if b {
+			counts[i%8]++
+		}
+	}
+
+	// each position is drawn 1000 times, so should be true roughly 500
+	for pos, n := range counts {
+		c.Check(n > 400 && n < 600, Equals, true, Commentf("position %d true %d times", pos, n))
+	}
+}
+
+func (*TestSuite) BenchmarkBoolSource(c *C) {
+	var bs securerandom.BoolSource
+
+	for i := 0; i < c.N; i++ {
+		bs.Next()
+	}
+}
+
+func (*TestSuite) TestFailureInjector(c *C) {
+	var fi *securerandom.FailureInjector
+	var fail bool
+	var err error
+
+	_, err = securerandom.NewFailureInjector(-0.1)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.NewFailureInjector(1.1)
+	c.Check(err, NotNil)
+
+	fi, err = securerandom.NewFailureInjector(0)
+	c.Assert(err, IsNil)
+
+	c.Check(fi.SetRate(2), NotNil)
+
+	// failures is how many of 4000 calls to ShouldFail returned true
+	failures := func() int {
+		var n int
+
+		for i := 0; i < 4000; i++ {
+			fail, err = fi.ShouldFail()
+			c.Assert(err, IsNil)
+
+			if fail {
+				n++
+			}
+		}
+
+		return n
+	}
+
+	// an invalid rate must leave the old one in place
+	c.Check(failures(), Equals, 0)
+
+	c.Assert(fi.SetRate(0.25), IsNil)
+
+	// expecting 1000, with a standard deviation of about 27
+	n := failures()
+	c.Check(n > 850 && n < 1150, Equals, true, Commentf("failures: %d", n))
+
+	c.Assert(fi.SetRate(0.75), IsNil)
+
+	n = failures()
+	c.Check(n > 2850 && n < 3150, Equals, true, Commentf("failures: %d", n))
+
+	c.Assert(fi.SetRate(1), IsNil)
+	c.Check(failures(), Equals, 4000)
+}
+
+func (*TestSuite) BenchmarkFailureInjector(c *C) {
+	fi, _ := securerandom.NewFailureInjector(0.5)
+
+	for i := 0; i < c.N; i++ {
+		fi.ShouldFail()
+	}
+}
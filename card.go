@@ -0,0 +1,90 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cardNetwork describes how to build test card numbers for a payment network.
+type cardNetwork struct {
+	// prefixes are the Issuer Identification Number prefixes to choose from;
+	// these are taken from the numbers published by payment processors for
+	// testing.
+	prefixes []string
+
+	// length is the total number of digits, including the check digit.
+	length int
+}
+
+// cardNetworks are the networks supported by RandomTestCreditCard, keyed by
+// their lowercased name.
+var cardNetworks = map[string]cardNetwork{
+	"visa":       {prefixes: []string{"411111", "401288"}, length: 16},
+	"mastercard": {prefixes: []string{"555555", "510510", "222300"}, length: 16},
+	"amex":       {prefixes: []string{"378282", "371449"}, length: 15},
+}
+
+// RandomTestCreditCard is a function that returns a random credit card number
+// for the requested network, which may be "visa", "mastercard", or "amex"
+// (case-insensitive). The number has the correct length and prefix for the
+// network, and a valid Luhn check digit. The prefixes are drawn from those
+// commonly published for testing, but the numbers are otherwise random and must
+// only be used against test payment systems.
+func RandomTestCreditCard(network string) (string, error) {
+	cn, ok := cardNetworks[strings.ToLower(network)]
+
+	if !ok {
+		return "", fmt.Errorf("unknown card network %q", network)
+	}
+
+	prefix, err := pick(cn.prefixes)
+
+	if err != nil {
+		return "", err
+	}
+
+	digits := make([]byte, cn.length)
+	copy(digits, prefix)
+
+	for i := len(prefix); i < cn.length-1; i++ {
+		n, err := intn(10)
+
+		if err != nil {
+			return "", err
+		}
+
+		digits[i] = byte('0' + n)
+	}
+
+	digits[cn.length-1] = luhnCheckDigit(digits[:cn.length-1])
+
+	return string(digits), nil
+}
+
+// luhnCheckDigit is a function that returns the ASCII digit that makes the
+// provided ASCII digits, with it appended, pass the Luhn checksum.
+func luhnCheckDigit(digits []byte) byte {
+	var sum int
+
+	// walk from the rightmost digit, which will be doubled once the
+	// check digit is appended after it
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+
+		if (len(digits)-1-i)%2 == 0 {
+			d *= 2
+
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
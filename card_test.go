@@ -0,0 +1,87 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"strings"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// luhnValid is a function that returns whether the string of digits passes
+// the Luhn checksum.
+func luhnValid(s string) bool {
+	var sum int
+
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+
+		if d < 0 || d > 9 {
+			return false
+		}
+
+		if (len(s)-1-i)%2 == 1 {
+			d *= 2
+
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+func (*TestSuite) TestRandomTestCreditCard(c *C) {
+	var s string
+	var err error
+
+	_, err = securerandom.RandomTestCreditCard("discover")
+	c.Check(err, NotNil)
+
+	// known-good test numbers, to make sure the validator itself is sane
+	c.Check(luhnValid("4111111111111111"), Equals, true)
+	c.Check(luhnValid("378282246310005"), Equals, true)
+	c.Check(luhnValid("4111111111111112"), Equals, false)
+
+	tests := []struct {
+		network  string
+		prefixes []string
+		length   int
+	}{
+		{"visa", []string{"4"}, 16},
+		{"Mastercard", []string{"51", "52", "53", "54", "55", "2"}, 16},
+		{"AMEX", []string{"34", "37"}, 15},
+	}
+
+	for _, t := range tests {
+		for i := 0; i < 50; i++ {
+			s, err = securerandom.RandomTestCreditCard(t.network)
+			c.Assert(err, IsNil)
+			c.Check(len(s), Equals, t.length)
+			c.Check(luhnValid(s), Equals, true, Commentf("number: %s", s))
+
+			var ok bool
+
+			for _, p := range t.prefixes {
+				if strings.HasPrefix(s, p) {
+					ok = true
+				}
+			}
+
+			c.Check(ok, Equals, true, Commentf("%s number: %s", t.network, s))
+		}
+	}
+}
+
+func (*TestSuite) BenchmarkRandomTestCreditCard(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomTestCreditCard("visa")
+	}
+}
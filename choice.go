@@ -0,0 +1,121 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "errors"
+
+// ChoiceExcluding is a function that returns a uniformly chosen element of s
+// that is not equal to exclude. This is useful for avoiding immediate repeats,
+// such as not playing the same song twice in a row. If s contains no elements
+// other than exclude an error is returned.
+func ChoiceExcluding[T comparable](s []T, exclude T) (T, error) {
+	var zero T
+
+	eligible := make([]int, 0, len(s))
+
+	for i, v := range s {
+		if v != exclude {
+			eligible = append(eligible, i)
+		}
+	}
+
+	if len(eligible) == 0 {
+		return zero, errors.New("s contains no values other than the excluded one")
+	}
+
+	n, err := intn(len(eligible))
+
+	if err != nil {
+		return zero, err
+	}
+
+	return s[eligible[n]], nil
+}
+
+// DistinctPair is a function that returns two distinct indices, each uniformly
+// distributed in the half-open interval [0, n), without shuffling. Every
+// ordered pair (i, j) with i != j is equally likely. The value of n must be at
+// least 2.
+func DistinctPair(n int) (i, j int, err error) {
+	if n < 2 {
+		return 0, 0, errors.New("n must be at least 2")
+	}
+
+	if i, err = intn(n); err != nil {
+		return 0, 0, err
+	}
+
+	// draw from the n-1 remaining indices, skipping over i
+	if j, err = intn(n - 1); err != nil {
+		return 0, 0, err
+	}
+
+	if j >= i {
+		j++
+	}
+
+	return i, j, nil
+}
+
+// MultiTierDraw is a function that draws winners for a multi-tier giveaway,
+// returning one slice of winners for each of the tier sizes, in the same order.
+// Winners are sampled without replacement from the whole pool, so no entry
+// wins in more than one tier. Entries are distinguished by their position, so
+// an entry listed twice may win twice. Each tier size must not be negative,
+// and together they must not exceed the number of entries.
+func MultiTierDraw(entries []string, tierSizes []int) ([][]string, error) {
+	var total int
+
+	for _, n := range tierSizes {
+		if n < 0 {
+			return nil, errors.New("tier sizes must not be negative")
+		}
+
+		// checked before adding so that large sizes can't overflow total
+		if n > len(entries)-total {
+			return nil, errors.New("tier sizes must not exceed the number of entries")
+		}
+
+		total += n
+	}
+
+	winners, err := sampleIndices(len(entries), total)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the sampled indices aren't in a random order, so shuffle them before
+	// dividing them into tiers
+	if err := shuffle(len(winners), func(i, j int) { winners[i], winners[j] = winners[j], winners[i] }); err != nil {
+		return nil, err
+	}
+
+	tiers := make([][]string, len(tierSizes))
+
+	for i, n := range tierSizes {
+		tiers[i] = make([]string, n)
+
+		for j := range tiers[i] {
+			tiers[i][j] = entries[winners[0]]
+			winners = winners[1:]
+		}
+	}
+
+	return tiers, nil
+}
+
+// pick is a function that returns a uniformly chosen element of s, which must
+// not be empty.
+func pick[T any](s []T) (T, error) {
+	n, err := intn(len(s))
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return s[n], nil
+}
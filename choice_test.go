@@ -0,0 +1,131 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"math"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestChoiceExcluding(c *C) {
+	var v string
+	var err error
+
+	_, err = securerandom.ChoiceExcluding([]string{}, "a")
+	c.Check(err, NotNil)
+
+	_, err = securerandom.ChoiceExcluding([]string{"a", "a"}, "a")
+	c.Check(err, NotNil)
+
+	seen := make(map[string]int)
+
+	for i := 0; i < 1000; i++ {
+		v, err = securerandom.ChoiceExcluding([]string{"a", "b", "c", "d"}, "c")
+		c.Assert(err, IsNil)
+		seen[v]++
+	}
+
+	c.Check(seen["c"], Equals, 0)
+	c.Check(seen["a"] > 0, Equals, true)
+	c.Check(seen["b"] > 0, Equals, true)
+	c.Check(seen["d"] > 0, Equals, true)
+}
+
+func (*TestSuite) BenchmarkChoiceExcluding(c *C) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	for i := 0; i < c.N; i++ {
+		securerandom.ChoiceExcluding(s, 4)
+	}
+}
+
+func (*TestSuite) TestDistinctPair(c *C) {
+	var i, j int
+	var err error
+
+	_, _, err = securerandom.DistinctPair(1)
+	c.Check(err, NotNil)
+
+	const n = 4
+
+	seen := make(map[[2]int]int)
+
+	for k := 0; k < 2000; k++ {
+		i, j, err = securerandom.DistinctPair(n)
+		c.Assert(err, IsNil)
+		c.Assert(i, Not(Equals), j)
+		c.Assert(i >= 0 && i < n, Equals, true)
+		c.Assert(j >= 0 && j < n, Equals, true)
+		seen[[2]int{i, j}]++
+	}
+
+	// all n*(n-1) ordered pairs should be reachable
+	c.Check(len(seen), Equals, n*(n-1))
+}
+
+func (*TestSuite) BenchmarkDistinctPair(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.DistinctPair(100)
+	}
+}
+
+func (*TestSuite) TestMultiTierDraw(c *C) {
+	var tiers [][]string
+	var err error
+
+	entries := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	_, err = securerandom.MultiTierDraw(entries, []int{1, -1})
+	c.Check(err, NotNil)
+
+	_, err = securerandom.MultiTierDraw(entries, []int{4, 3, 2})
+	c.Check(err, NotNil)
+
+	_, err = securerandom.MultiTierDraw([]string{"a", "b"}, []int{math.MaxInt, 2})
+	c.Check(err, NotNil)
+
+	tiers, err = securerandom.MultiTierDraw(entries, nil)
+	c.Assert(err, IsNil)
+	c.Check(len(tiers), Equals, 0)
+
+	firsts := make(map[string]int)
+
+	for i := 0; i < 800; i++ {
+		tiers, err = securerandom.MultiTierDraw(entries, []int{1, 3, 0, 4})
+		c.Assert(err, IsNil)
+		c.Assert(len(tiers), Equals, 4)
+
+		won := make(map[string]bool)
+
+		for t, size := range []int{1, 3, 0, 4} {
+			c.Assert(len(tiers[t]), Equals, size)
+
+			for _, w := range tiers[t] {
+				c.Assert(won[w], Equals, false, Commentf("%s won twice", w))
+				won[w] = true
+			}
+		}
+
+		firsts[tiers[0][0]]++
+	}
+
+	// every entry should be able to win the top tier
+	c.Check(len(firsts), Equals, len(entries))
+
+	for e, n := range firsts {
+		c.Check(n > 40, Equals, true, Commentf("%s won the top tier %d times", e, n))
+	}
+}
+
+func (*TestSuite) BenchmarkMultiTierDraw(c *C) {
+	entries := make([]string, 100)
+
+	for i := 0; i < c.N; i++ {
+		securerandom.MultiTierDraw(entries, []int{1, 3, 10})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// TestConcurrentUse hammers the package from many goroutines at once. It's
+// only meaningful when run with the race detector enabled:
+//
+//	go test -race ./...
+func (*TestSuite) TestConcurrentUse(c *C) {
+	const goroutines = 16
+	const iterations = 200
+
+	var bs securerandom.BoolSource
+
+	ap, err := securerandom.NewAdaptivePicker([]int{1, 2, 3, 4})
+	c.Assert(err, IsNil)
+
+	nrp, err := securerandom.NewNoRepeatPicker([]int{1, 2, 3, 4}, 2)
+	c.Assert(err, IsNil)
+
+	cp, err := securerandom.NewCyclicPicker([]int{1, 2, 3, 4})
+	c.Assert(err, IsNil)
+
+	fi, err := securerandom.NewFailureInjector(0.5)
+	c.Assert(err, IsNil)
+
+	var recording bytes.Buffer
+
+	rec := securerandom.RecordingReader(&recording)
+	rz := securerandom.New(rec)
+
+	shared := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	// each goroutine runs every operation, and returns the first error
+	ops := []func() error{
+		func() error { _, err := securerandom.Bytes(16); return err },
+		func() error { _, err := securerandom.Int64(); return err },
+		func() error { _, err := securerandom.ShuffledValues(shared); return err },
+		func() error { _, err := securerandom.ChoiceExcluding(shared, 4); return err },
+		func() error { _, err := bs.Next(); return err },
+		func() error { return ap.Reward(3) },
+		func() error { _, err := ap.Pick(); return err },
+		func() error { _, err := nrp.Pick(); return err },
+		func() error { _, err := cp.Next(); return err },
+		func() error { return fi.SetRate(0.25) },
+		func() error { _, err := fi.ShouldFail(); return err },
+		func() error { _, err := rec.Read(make([]byte, 8)); return err },
+		func() error { _, err := rz.Int64(); return err },
+		func() error { _, err := rz.Intn(5); return err },
+	}
+
+	errs := make(chan error, goroutines)
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < iterations; i++ {
+				for _, op := range ops {
+					if err := op(); err != nil {
+						errs <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		c.Check(err, IsNil)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"math"
+)
+
+// RejectionSample is a function that draws a value from the arbitrary
+// distribution described by pdf over the interval [lo, hi], using classic
+// rejection sampling: a point x is drawn uniformly from [lo, hi) and a point y
+// from [0, maxPdf), and x is accepted if y < pdf(x). The value of maxPdf must
+// be finite, greater than zero, and at least the maximum of pdf over the interval, or
+// the result will be skewed. The value of hi must be greater than lo.
+//
+// Sampling continues until a value is accepted, so pdf must be positive over
+// some of the interval or this will never return.
+func RejectionSample(lo, hi, maxPdf float64, pdf func(float64) float64) (float64, error) {
+	if !(hi > lo) || math.IsInf(hi-lo, 0) {
+		return 0, errors.New("hi must be greater than lo")
+	}
+
+	if !(maxPdf > 0) || math.IsInf(maxPdf, 1) {
+		return 0, errors.New("maxPdf must be finite and greater than zero")
+	}
+
+	if pdf == nil {
+		return 0, errors.New("pdf must not be nil")
+	}
+
+	for {
+		fx, err := float64n()
+
+		if err != nil {
+			return 0, err
+		}
+
+		fy, err := float64n()
+
+		if err != nil {
+			return 0, err
+		}
+
+		x := lo + fx*(hi-lo)
+
+		if fy*maxPdf < pdf(x) {
+			return x, nil
+		}
+	}
+}
+
+// RandomLevel is a function that returns a level for a new skip list node,
+// drawn from the geometric distribution truncated at maxLevel. Starting at
+// level 1, the level is incremented for as long as a trial with probability p
+// succeeds, up to maxLevel. The value of maxLevel must be at least 1, and p must
+// be in the open interval (0, 1).
+func RandomLevel(maxLevel int, p float64) (int, error) {
+	if maxLevel < 1 {
+		return 0, errors.New("maxLevel must be at least 1")
+	}
+
+	if !(p > 0 && p < 1) {
+		return 0, errors.New("p must be in the range (0, 1)")
+	}
+
+	level := 1
+
+	for level < maxLevel {
+		f, err := float64n()
+
+		if err != nil {
+			return 0, err
+		}
+
+		if f >= p {
+			break
+		}
+
+		level++
+	}
+
+	return level, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"math"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestRejectionSample(c *C) {
+	var x float64
+	var err error
+
+	triangle := func(x float64) float64 { return 2 * x }
+
+	_, err = securerandom.RejectionSample(1, 1, 2, triangle)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RejectionSample(0, 1, 0, triangle)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RejectionSample(0, 1, math.Inf(1), triangle)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RejectionSample(0, 1, 2, nil)
+	c.Check(err, NotNil)
+
+	const runs = 8000
+
+	// for f(x) = 2x on [0, 1], the CDF is x^2, so each quarter of the
+	// interval should hold 1/16, 3/16, 5/16, and 7/16 of the samples
+	var counts [4]int
+
+	for i := 0; i < runs; i++ {
+		x, err = securerandom.RejectionSample(0, 1, 2, triangle)
+		c.Assert(err, IsNil)
+		c.Assert(x >= 0 && x < 1, Equals, true)
+		counts[int(x*4)]++
+	}
+
+	for i, n := range counts {
+		expected := float64(2*i+1) / 16
+		got := float64(n) / runs
+		c.Check(math.Abs(got-expected) < 0.03, Equals, true, Commentf("bucket %d: got %f, expected %f", i, got, expected))
+	}
+}
+
+func (*TestSuite) BenchmarkRejectionSample(c *C) {
+	triangle := func(x float64) float64 { return 2 * x }
+
+	for i := 0; i < c.N; i++ {
+		securerandom.RejectionSample(0, 1, 2, triangle)
+	}
+}
+
+func (*TestSuite) TestRandomLevel(c *C) {
+	var level int
+	var err error
+
+	_, err = securerandom.RandomLevel(0, 0.5)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RandomLevel(8, 0)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RandomLevel(8, 1)
+	c.Check(err, NotNil)
+
+	level, err = securerandom.RandomLevel(1, 0.9)
+	c.Assert(err, IsNil)
+	c.Check(level, Equals, 1)
+
+	const runs = 8000
+	const maxLevel = 4
+	const p = 0.5
+
+	counts := make([]int, maxLevel+1)
+
+	for i := 0; i < runs; i++ {
+		level, err = securerandom.RandomLevel(maxLevel, p)
+		c.Assert(err, IsNil)
+		c.Assert(level >= 1 && level <= maxLevel, Equals, true)
+		counts[level]++
+	}
+
+	// P(level = k) = p^(k-1) * (1-p) below the cap, and the cap
+	// absorbs the remaining p^(maxLevel-1)
+	for k := 1; k <= maxLevel; k++ {
+		expected := math.Pow(p, float64(k-1))
+
+		if k < maxLevel {
+			expected *= 1 - p
+		}
+
+		got := float64(counts[k]) / runs
+		c.Check(math.Abs(got-expected) < 0.03, Equals, true, Commentf("level %d: got %f, expected %f", k, got, expected))
+	}
+}
+
+func (*TestSuite) BenchmarkRandomLevel(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomLevel(16, 0.25)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+const (
+	// maxFillLength is the most elements Fill puts in a slice or map.
+	maxFillLength = 4
+
+	// maxFillDepth is how deeply Fill follows pointers, slices, and maps,
+	// which stops it from recursing forever on self-referential types.
+	maxFillDepth = 8
+
+	// fillStringBytes is the number of random bytes used to generate each
+	// string filled in by Fill.
+	fillStringBytes = 12
+)
+
+// Fill is a function that takes a pointer to a struct, and recursively fills
+// its exported fields with random values of the appropriate type. Supported
+// field types are bools, numbers, strings, and any pointers, arrays, slices,
+// maps, or structs made of them. Slices and maps are given between 1 and 4
+// elements. Fields tagged with `rand:"-"` are left untouched, as are
+// unexported fields.
+//
+// An error is returned if v isn't a non-nil pointer to a struct, or if a field
+// has a type that can't be filled (e.g., a channel or a func).
+func Fill(v interface{}) error {
+	rv := reflect.ValueOf(v)
+
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("v must be a non-nil pointer to a struct")
+	}
+
+	return fillValue(rv.Elem(), 0)
+}
+
+// fillValue is a function that fills rv, which must be settable, with a random
+// value of its type.
+func fillValue(rv reflect.Value, depth int) error {
+	switch rv.Kind() {
+	case reflect.Bool:
+		b, err := Bool()
+
+		if err != nil {
+			return err
+		}
+
+		rv.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i64, err := Int64()
+
+		if err != nil {
+			return err
+		}
+
+		rv.SetInt(i64)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u64, err := Uint64()
+
+		if err != nil {
+			return err
+		}
+
+		rv.SetUint(u64)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := float64n()
+
+		if err != nil {
+			return err
+		}
+
+		rv.SetFloat(f)
+
+	case reflect.Complex64, reflect.Complex128:
+		r, err := float64n()
+
+		if err != nil {
+			return err
+		}
+
+		i, err := float64n()
+
+		if err != nil {
+			return err
+		}
+
+		rv.SetComplex(complex(r, i))
+
+	case reflect.String:
+		s, err := URLBase64OfBytes(fillStringBytes)
+
+		if err != nil {
+			return err
+		}
+
+		rv.SetString(s)
+
+	case reflect.Ptr:
+		if depth >= maxFillDepth {
+			return nil
+		}
+
+		p := reflect.New(rv.Type().Elem())
+
+		if err := fillValue(p.Elem(), depth+1); err != nil {
+			return err
+		}
+
+		rv.Set(p)
+
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if err := fillValue(rv.Index(i), depth); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice:
+		if depth >= maxFillDepth {
+			return nil
+		}
+
+		n, err := intn(maxFillLength)
+
+		if err != nil {
+			return err
+		}
+
+		s := reflect.MakeSlice(rv.Type(), n+1, n+1)
+
+		for i := 0; i < s.Len(); i++ {
+			if err := fillValue(s.Index(i), depth+1); err != nil {
+				return err
+			}
+		}
+
+		rv.Set(s)
+
+	case reflect.Map:
+		if depth >= maxFillDepth {
+			return nil
+		}
+
+		n, err := intn(maxFillLength)
+
+		if err != nil {
+			return err
+		}
+
+		t := rv.Type()
+		m := reflect.MakeMapWithSize(t, n+1)
+
+		for i := 0; i <= n; i++ {
+			k := reflect.New(t.Key()).Elem()
+			e := reflect.New(t.Elem()).Elem()
+
+			if err := fillValue(k, depth+1); err != nil {
+				return err
+			}
+
+			if err := fillValue(e, depth+1); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(k, e)
+		}
+
+		rv.Set(m)
+
+	case reflect.Struct:
+		t := rv.Type()
+
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+
+			if f.PkgPath != "" || f.Tag.Get("rand") == "-" {
+				continue
+			}
+
+			if err := fillValue(rv.Field(i), depth); err != nil {
+				return fmt.Errorf("field %s: %w", f.Name, err)
+			}
+		}
+
+	default:
+		return fmt.Errorf("unable to fill value of type %s", rv.Type())
+	}
+
+	return nil
+}
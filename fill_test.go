@@ -0,0 +1,90 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+type fillInner struct {
+	Name  string
+	Count uint16
+}
+
+type fillSample struct {
+	Int     int
+	Int8    int8
+	Uint64  uint64
+	Float   float64
+	Bool    bool
+	String  string
+	Bytes   []byte
+	Strings []string
+	Array   [3]int32
+	Map     map[string]int
+	Inner   fillInner
+	Ptr     *fillInner
+	Next    *fillSample
+	Skipped string `rand:"-"`
+	private string
+}
+
+func (*TestSuite) TestFill(c *C) {
+	var err error
+
+	c.Check(securerandom.Fill(nil), NotNil)
+	c.Check(securerandom.Fill(fillSample{}), NotNil)
+	c.Check(securerandom.Fill((*fillSample)(nil)), NotNil)
+
+	i := 42
+	c.Check(securerandom.Fill(&i), NotNil)
+
+	var bad struct{ Ch chan int }
+	c.Check(securerandom.Fill(&bad), NotNil)
+
+	var s fillSample
+	s.Skipped = "untouched"
+
+	err = securerandom.Fill(&s)
+	c.Assert(err, IsNil)
+
+	c.Check(s.String, Not(Equals), "")
+	c.Check(len(s.Bytes) > 0, Equals, true)
+	c.Check(len(s.Strings) > 0, Equals, true)
+	c.Check(len(s.Map) > 0, Equals, true)
+	c.Check(s.Inner.Name, Not(Equals), "")
+	c.Assert(s.Ptr, NotNil)
+	c.Check(s.Ptr.Name, Not(Equals), "")
+	c.Assert(s.Next, NotNil)
+	c.Check(s.Float >= 0 && s.Float < 1, Equals, true)
+	c.Check(s.Skipped, Equals, "untouched")
+	c.Check(s.private, Equals, "")
+
+	for _, str := range s.Strings {
+		c.Check(str, Not(Equals), "")
+	}
+
+	// numbers could legitimately come up zero, so only check that at
+	// least some of them were populated
+	c.Check(s.Int != 0 || s.Int8 != 0 || s.Uint64 != 0 || s.Array != [3]int32{}, Equals, true)
+
+	// the self-referential pointer must stop at some depth
+	depth := 0
+
+	for n := &s; n != nil; n = n.Next {
+		depth++
+	}
+
+	c.Check(depth > 1 && depth < 100, Equals, true)
+}
+
+func (*TestSuite) BenchmarkFill(c *C) {
+	for i := 0; i < c.N; i++ {
+		var s fillInner
+		securerandom.Fill(&s)
+	}
+}
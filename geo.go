@@ -0,0 +1,120 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	// polygonAreaTolerance is the smallest fraction of its bounding box a
+	// polygon may cover before PointInPolygon treats it as degenerate.
+	polygonAreaTolerance = 1e-6
+
+	// maxPolygonAttempts is how many points PointInPolygon draws from the
+	// bounding box before giving up.
+	maxPolygonAttempts = 1e7
+)
+
+// Point is a point on a two-dimensional plane.
+type Point struct {
+	X, Y float64
+}
+
+// PointInPolygon is a function that returns a point uniformly distributed over
+// the area of the simple polygon described by its vertices. The point is found
+// by drawing points uniformly from the polygon's bounding box until one falls
+// inside of the polygon. There must be at least 3 vertices with finite
+// coordinates, and the polygon must cover at least a millionth of its bounding
+// box. An error is returned if no point is found after a fixed number of draws.
+func PointInPolygon(polygon []Point) (Point, error) {
+	if len(polygon) < 3 {
+		return Point{}, errors.New("polygon must have at least 3 vertices")
+	}
+
+	min, max := polygon[0], polygon[0]
+	var area float64
+
+	for i, p := range polygon {
+		if math.IsNaN(p.X) || math.IsInf(p.X, 0) || math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+			return Point{}, errors.New("polygon coordinates must be finite")
+		}
+
+		if p.X < min.X {
+			min.X = p.X
+		}
+
+		if p.Y < min.Y {
+			min.Y = p.Y
+		}
+
+		if p.X > max.X {
+			max.X = p.X
+		}
+
+		if p.Y > max.Y {
+			max.Y = p.Y
+		}
+
+		// shoelace formula, only used to rule out degenerate polygons
+		q := polygon[(i+1)%len(polygon)]
+		area += p.X*q.Y - q.X*p.Y
+	}
+
+	width, height := max.X-min.X, max.Y-min.Y
+
+	if math.IsInf(width*height, 0) || math.IsInf(area, 0) {
+		return Point{}, errors.New("polygon is too large")
+	}
+
+	// the shoelace sum is twice the area, and can be a rounding residue rather
+	// than exactly zero for degenerate polygons, so compare it to the bounding box
+	if !(math.Abs(area)/2 > polygonAreaTolerance*width*height) {
+		return Point{}, errors.New("polygon must have a non-zero area")
+	}
+
+	for i := 0; i < maxPolygonAttempts; i++ {
+		fx, err := float64n()
+
+		if err != nil {
+			return Point{}, err
+		}
+
+		fy, err := float64n()
+
+		if err != nil {
+			return Point{}, err
+		}
+
+		p := Point{
+			X: min.X + fx*width,
+			Y: min.Y + fy*height,
+		}
+
+		if containsPoint(polygon, p) {
+			return p, nil
+		}
+	}
+
+	return Point{}, errors.New("failed to find a point inside of the polygon")
+}
+
+// containsPoint is a function that returns whether p is inside of the polygon,
+// using the even-odd rule: a ray cast from p crosses the polygon's edges an odd
+// number of times if p is inside of it.
+func containsPoint(polygon []Point, p Point) bool {
+	var inside bool
+
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
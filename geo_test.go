@@ -0,0 +1,84 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"math"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestPointInPolygon(c *C) {
+	var p securerandom.Point
+	var err error
+
+	_, err = securerandom.PointInPolygon([]securerandom.Point{{0, 0}, {1, 1}})
+	c.Check(err, NotNil)
+
+	_, err = securerandom.PointInPolygon([]securerandom.Point{{0, 0}, {1, 1}, {2, 2}})
+	c.Check(err, NotNil)
+
+	// collinear, but the shoelace sum is a rounding residue rather than zero
+	_, err = securerandom.PointInPolygon([]securerandom.Point{{0.1, 0.1}, {0.2, 0.157142857142857}, {0.4, 0.271428571428571}})
+	c.Check(err, NotNil)
+
+	_, err = securerandom.PointInPolygon([]securerandom.Point{{0, 0}, {1, 0}, {math.NaN(), 1}})
+	c.Check(err, NotNil)
+
+	_, err = securerandom.PointInPolygon([]securerandom.Point{{0, 0}, {math.Inf(1), 0}, {0, 1}})
+	c.Check(err, NotNil)
+
+	triangle := []securerandom.Point{{0, 0}, {1, 0}, {0, 1}}
+
+	for i := 0; i < 500; i++ {
+		p, err = securerandom.PointInPolygon(triangle)
+		c.Assert(err, IsNil)
+		c.Assert(p.X >= 0 && p.Y >= 0 && p.X+p.Y <= 1, Equals, true, Commentf("point: %v", p))
+	}
+
+	// an L-shape made of three unit squares, each of which should hold
+	// roughly a third of the points:
+	//
+	//	+---+
+	//	| 2 |
+	//	+---+---+
+	//	| 0 | 1 |
+	//	+---+---+
+	lShape := []securerandom.Point{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+
+	const runs = 3000
+
+	var counts [3]int
+
+	for i := 0; i < runs; i++ {
+		p, err = securerandom.PointInPolygon(lShape)
+		c.Assert(err, IsNil)
+
+		switch {
+		case p.X < 1 && p.Y < 1:
+			counts[0]++
+		case p.X >= 1 && p.Y < 1:
+			counts[1]++
+		case p.X < 1 && p.Y >= 1:
+			counts[2]++
+		default:
+			c.Fatalf("point outside of polygon: %v", p)
+		}
+	}
+
+	for i, n := range counts {
+		c.Check(n > 850 && n < 1150, Equals, true, Commentf("square %d: %d points", i, n))
+	}
+}
+
+func (*TestSuite) BenchmarkPointInPolygon(c *C) {
+	triangle := []securerandom.Point{{0, 0}, {1, 0}, {0, 1}}
+
+	for i := 0; i < c.N; i++ {
+		securerandom.PointInPolygon(triangle)
+	}
+}
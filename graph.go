@@ -0,0 +1,42 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "errors"
+
+// RandomDAG is a function that returns the adjacency list of a random directed
+// acyclic graph with the number of nodes specified. Each possible edge from a
+// lower-indexed node to a higher-indexed one is added with probability
+// edgeProb, and as no edge ever points to a lower index the graph is guaranteed
+// to be acyclic. The adjacency list of each node is sorted in ascending order.
+// The number of nodes must not be negative, and edgeProb must be in the range
+// [0, 1].
+func RandomDAG(nodes int, edgeProb float64) ([][]int, error) {
+	if nodes < 0 {
+		return nil, errors.New("nodes must not be negative")
+	}
+
+	if !(edgeProb >= 0 && edgeProb <= 1) {
+		return nil, errors.New("edgeProb must be in the range [0, 1]")
+	}
+
+	adj := make([][]int, nodes)
+
+	for i := range adj {
+		for j := i + 1; j < nodes; j++ {
+			f, err := float64n()
+
+			if err != nil {
+				return nil, err
+			}
+
+			if f < edgeProb {
+				adj[i] = append(adj[i], j)
+			}
+		}
+	}
+
+	return adj, nil
+}
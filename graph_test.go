@@ -0,0 +1,91 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// topoSort is a function that returns a topological ordering of the graph
+// using Kahn's algorithm, and whether one exists (i.e., the graph is acyclic).
+func topoSort(adj [][]int) ([]int, bool) {
+	indegree := make([]int, len(adj))
+
+	for _, edges := range adj {
+		for _, j := range edges {
+			indegree[j]++
+		}
+	}
+
+	var queue, order []int
+
+	for i, d := range indegree {
+		if d == 0 {
+			queue = append(queue, i)
+		}
+	}
+
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		order = append(order, n)
+
+		for _, j := range adj[n] {
+			if indegree[j]--; indegree[j] == 0 {
+				queue = append(queue, j)
+			}
+		}
+	}
+
+	return order, len(order) == len(adj)
+}
+
+func (*TestSuite) TestRandomDAG(c *C) {
+	var adj [][]int
+	var err error
+
+	_, err = securerandom.RandomDAG(-1, 0.5)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RandomDAG(5, -0.1)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RandomDAG(5, 1.1)
+	c.Check(err, NotNil)
+
+	adj, err = securerandom.RandomDAG(0, 0.5)
+	c.Assert(err, IsNil)
+	c.Check(len(adj), Equals, 0)
+
+	// a cycle must be detected by the sort
+	_, ok := topoSort([][]int{{1}, {2}, {0}})
+	c.Check(ok, Equals, false)
+
+	// with a probability of 1 the graph is complete
+	adj, err = securerandom.RandomDAG(4, 1)
+	c.Assert(err, IsNil)
+	c.Check(adj, DeepEquals, [][]int{{1, 2, 3}, {2, 3}, {3}, nil})
+
+	adj, err = securerandom.RandomDAG(4, 0)
+	c.Assert(err, IsNil)
+	c.Check(adj, DeepEquals, [][]int{nil, nil, nil, nil})
+
+	for i := 0; i < 50; i++ {
+		adj, err = securerandom.RandomDAG(30, 0.3)
+		c.Assert(err, IsNil)
+		c.Assert(len(adj), Equals, 30)
+
+		_, ok = topoSort(adj)
+		c.Assert(ok, Equals, true)
+	}
+}
+
+func (*TestSuite) BenchmarkRandomDAG(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomDAG(32, 0.25)
+	}
+}
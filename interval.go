@@ -0,0 +1,55 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "errors"
+
+// OrderedFloatPair is a function that returns two uniformly distributed values
+// in the half-open interval [0.0, 1.0), ordered so that lo <= hi. This is
+// useful for generating valid (min, max) intervals.
+func OrderedFloatPair() (lo, hi float64, err error) {
+	if lo, err = float64n(); err != nil {
+		return 0, 0, err
+	}
+
+	if hi, err = float64n(); err != nil {
+		return 0, 0, err
+	}
+
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	return lo, hi, nil
+}
+
+// OrderedIntPair is a function that returns two uniformly distributed values
+// in the closed interval [min, max], ordered so that lo <= hi. The value of max
+// must not be less than min.
+func OrderedIntPair(min, max int) (lo, hi int, err error) {
+	if max < min {
+		return 0, 0, errors.New("max must not be less than min")
+	}
+
+	n := max - min + 1
+
+	if n <= 0 {
+		return 0, 0, errors.New("range between min and max is too large")
+	}
+
+	if lo, err = intn(n); err != nil {
+		return 0, 0, err
+	}
+
+	if hi, err = intn(n); err != nil {
+		return 0, 0, err
+	}
+
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+
+	return min + lo, min + hi, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestOrderedFloatPair(c *C) {
+	var lo, hi float64
+	var err error
+
+	for i := 0; i < 1000; i++ {
+		lo, hi, err = securerandom.OrderedFloatPair()
+		c.Assert(err, IsNil)
+		c.Assert(lo <= hi, Equals, true)
+		c.Assert(lo >= 0 && hi < 1, Equals, true)
+	}
+}
+
+func (*TestSuite) BenchmarkOrderedFloatPair(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.OrderedFloatPair()
+	}
+}
+
+func (*TestSuite) TestOrderedIntPair(c *C) {
+	var lo, hi int
+	var err error
+
+	_, _, err = securerandom.OrderedIntPair(5, 4)
+	c.Check(err, NotNil)
+
+	lo, hi, err = securerandom.OrderedIntPair(7, 7)
+	c.Assert(err, IsNil)
+	c.Check(lo, Equals, 7)
+	c.Check(hi, Equals, 7)
+
+	seen := make(map[int]struct{})
+
+	for i := 0; i < 1000; i++ {
+		lo, hi, err = securerandom.OrderedIntPair(-3, 3)
+		c.Assert(err, IsNil)
+		c.Assert(lo <= hi, Equals, true)
+		c.Assert(lo >= -3 && hi <= 3, Equals, true)
+
+		seen[lo] = struct{}{}
+		seen[hi] = struct{}{}
+	}
+
+	c.Check(len(seen), Equals, 7)
+}
+
+func (*TestSuite) BenchmarkOrderedIntPair(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.OrderedIntPair(0, 100)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"sync"
+)
+
+// chunkedReader is the io.Reader returned by ChunkedReader.
+type chunkedReader struct {
+	r        io.Reader
+	maxChunk int
+}
+
+// ChunkedReader is a function that wraps r in an io.Reader whose Read method
+// reads a random number of bytes, between 1 and maxChunk, from r per call, but
+// never more than the caller asked for. This is useful for testing that code
+// properly handles short reads. A maxChunk of less than 1 is treated as 1.
+func ChunkedReader(r io.Reader, maxChunk int) io.Reader {
+	if maxChunk < 1 {
+		maxChunk = 1
+	}
+
+	return &chunkedReader{r: r, maxChunk: maxChunk}
+}
+
+func (cr *chunkedReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return cr.r.Read(p)
+	}
+
+	max := cr.maxChunk
+
+	if max > len(p) {
+		max = len(p)
+	}
+
+	n, err := intn(max)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return cr.r.Read(p[:n+1])
+}
+
+// ErrReplayExhausted is returned by the reader from ReplayReader when all of
+// the recorded bytes have been consumed.
+var ErrReplayExhausted = errors.New("recorded random data has been exhausted")
+
+// recordingReader is the io.Reader returned by RecordingReader.
+type recordingReader struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// RecordingReader is a function that returns an io.Reader of secure-random
+// data from crypto/rand, which also writes every byte it reads to w. Passing
+// it to New captures the randomness a Randomizer uses in a session, such as a
+// fuzzing run, so it can be replayed later using ReplayReader. If writing to w
+// fails, the read returns the error. The reader is safe for concurrent use,
+// but the recording is only reproducible if the session's calls happen in a
+// deterministic order.
+func RecordingReader(w io.Writer) io.Reader {
+	return &recordingReader{w: w}
+}
+
+func (rr *recordingReader) Read(p []byte) (int, error) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	n, err := crand.Reader.Read(p)
+
+	if n > 0 {
+		if _, werr := rr.w.Write(p[:n]); werr != nil {
+			return n, werr
+		}
+	}
+
+	return n, err
+}
+
+// replayReader is the io.Reader returned by ReplayReader.
+type replayReader struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+// ReplayReader is a function that returns an io.Reader which feeds back the
+// bytes previously captured using RecordingReader, read from r. Passing it to
+// New and making the same calls on the Randomizer as the recorded session
+// reproduces its results exactly. Once the recording has been used up, reads
+// return ErrReplayExhausted instead of io.EOF, as a source of randomness isn't
+// expected to run dry. The reader is safe for concurrent use.
+func ReplayReader(r io.Reader) io.Reader {
+	return &replayReader{r: r}
+}
+
+func (rr *replayReader) Read(p []byte) (int, error) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	n, err := rr.r.Read(p)
+
+	if err == io.EOF {
+		if n > 0 {
+			return n, nil
+		}
+
+		return 0, ErrReplayExhausted
+	}
+
+	return n, err
+}
@@ -0,0 +1,144 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestChunkedReader(c *C) {
+	var data, got []byte
+	var err error
+
+	data, err = securerandom.Bytes(4096)
+	c.Assert(err, IsNil)
+
+	const maxChunk = 100
+
+	r := securerandom.ChunkedReader(bytes.NewReader(data), maxChunk)
+	buf := make([]byte, 512)
+	sizes := make(map[int]struct{})
+
+	for {
+		n, err := r.Read(buf)
+
+		if n > 0 {
+			c.Assert(n <= maxChunk, Equals, true)
+			sizes[n] = struct{}{}
+			got = append(got, buf[:n]...)
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		c.Assert(err, IsNil)
+	}
+
+	c.Check(got, DeepEquals, data)
+	c.Check(len(sizes) > 1, Equals, true)
+
+	// reads are never larger than requested
+	r = securerandom.ChunkedReader(bytes.NewReader(data), maxChunk)
+	n, err := r.Read(buf[:3])
+	c.Assert(err, IsNil)
+	c.Check(n >= 1 && n <= 3, Equals, true)
+
+	// a non-positive maxChunk reads a byte at a time
+	r = securerandom.ChunkedReader(bytes.NewReader(data), 0)
+	n, err = r.Read(buf)
+	c.Assert(err, IsNil)
+	c.Check(n, Equals, 1)
+
+	got, err = io.ReadAll(securerandom.ChunkedReader(bytes.NewReader(data), 7))
+	c.Assert(err, IsNil)
+	c.Check(got, DeepEquals, data)
+}
+
+func (*TestSuite) BenchmarkChunkedReader(c *C) {
+	data := make([]byte, 4096)
+	buf := make([]byte, 512)
+
+	for i := 0; i < c.N; i++ {
+		r := securerandom.ChunkedReader(bytes.NewReader(data), 256)
+
+		for {
+			if _, err := r.Read(buf); err != nil {
+				break
+			}
+		}
+
+		c.SetBytes(int64(len(data)))
+	}
+}
+
+// session is a function that simulates a session consuming randomness from
+// rz, by drawing some int64s and choosing from a slice.
+func session(rz *securerandom.Randomizer) ([]int64, []string, error) {
+	choices := []string{"a", "b", "c", "d", "e"}
+
+	var ints []int64
+	var picks []string
+
+	for i := 0; i < 10; i++ {
+		i64, err := rz.Int64()
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		n, err := rz.Intn(len(choices))
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ints = append(ints, i64)
+		picks = append(picks, choices[n])
+	}
+
+	return ints, picks, nil
+}
+
+func (*TestSuite) TestRecordingReplayReader(c *C) {
+	var recording bytes.Buffer
+
+	ints, picks, err := session(securerandom.New(securerandom.RecordingReader(&recording)))
+	c.Assert(err, IsNil)
+	c.Assert(recording.Len() > 0, Equals, true)
+
+	saved := append([]byte(nil), recording.Bytes()...)
+	rz := securerandom.New(securerandom.ReplayReader(bytes.NewReader(saved)))
+
+	replayInts, replayPicks, err := session(rz)
+	c.Assert(err, IsNil)
+	c.Check(replayInts, DeepEquals, ints)
+	c.Check(replayPicks, DeepEquals, picks)
+
+	// the whole recording was consumed, so further draws must fail
+	_, err = rz.Int64()
+	c.Check(err, Equals, securerandom.ErrReplayExhausted)
+
+	// a second recorded session should differ from the first
+	otherInts, _, err := session(securerandom.New(securerandom.RecordingReader(&bytes.Buffer{})))
+	c.Assert(err, IsNil)
+	c.Check(otherInts, Not(DeepEquals), ints)
+
+	// running past the end of the recording is an error, not io.EOF
+	r := securerandom.ReplayReader(bytes.NewReader(saved))
+	buf := make([]byte, len(saved))
+
+	_, err = io.ReadFull(r, buf)
+	c.Assert(err, IsNil)
+	c.Check(buf, DeepEquals, saved)
+
+	_, err = r.Read(buf)
+	c.Check(err, Equals, securerandom.ErrReplayExhausted)
+}
@@ -0,0 +1,82 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"strings"
+)
+
+// loremWords is the word list used to generate lorem-ipsum-style text.
+var loremWords = []string{
+	"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing",
+	"elit", "sed", "do", "eiusmod", "tempor", "incididunt", "ut", "labore",
+	"et", "dolore", "magna", "aliqua", "enim", "ad", "minim", "veniam",
+	"quis", "nostrud", "exercitation", "ullamco", "laboris", "nisi",
+	"aliquip", "ex", "ea", "commodo", "consequat", "duis", "aute", "irure",
+	"in", "reprehenderit", "voluptate", "velit", "esse", "cillum", "eu",
+	"fugiat", "nulla", "pariatur", "excepteur", "sint", "occaecat",
+	"cupidatat", "non", "proident", "sunt", "culpa", "qui", "officia",
+	"deserunt", "mollit", "anim", "id", "est", "laborum",
+}
+
+const (
+	// minSentenceWords is the fewest words in a sentence generated by Paragraph.
+	minSentenceWords = 4
+
+	// maxSentenceWords is the most words in a sentence generated by Paragraph.
+	maxSentenceWords = 12
+)
+
+// Sentence is a function that returns a lorem-ipsum-style sentence containing
+// the number of words specified. The sentence starts with a capital letter and
+// ends with a period. The number of words must be at least 1.
+func Sentence(words int) (string, error) {
+	if words < 1 {
+		return "", errors.New("words must be at least 1")
+	}
+
+	w := make([]string, words)
+
+	for i := range w {
+		n, err := intn(len(loremWords))
+
+		if err != nil {
+			return "", err
+		}
+
+		w[i] = loremWords[n]
+	}
+
+	w[0] = strings.ToUpper(w[0][:1]) + w[0][1:]
+
+	return strings.Join(w, " ") + ".", nil
+}
+
+// Paragraph is a function that returns a lorem-ipsum-style paragraph
+// containing the number of sentences specified, separated by single spaces.
+// Each sentence has a random number of words. The number of sentences must be
+// at least 1.
+func Paragraph(sentences int) (string, error) {
+	if sentences < 1 {
+		return "", errors.New("sentences must be at least 1")
+	}
+
+	s := make([]string, sentences)
+
+	for i := range s {
+		n, err := intn(maxSentenceWords - minSentenceWords + 1)
+
+		if err != nil {
+			return "", err
+		}
+
+		if s[i], err = Sentence(minSentenceWords + n); err != nil {
+			return "", err
+		}
+	}
+
+	return strings.Join(s, " "), nil
+}
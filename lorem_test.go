@@ -0,0 +1,56 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestSentence(c *C) {
+	var s string
+	var err error
+
+	_, err = securerandom.Sentence(0)
+	c.Check(err, NotNil)
+
+	for _, n := range []int{1, 2, 10, 50} {
+		s, err = securerandom.Sentence(n)
+		c.Assert(err, IsNil)
+		c.Check(unicode.IsUpper(rune(s[0])), Equals, true)
+		c.Check(strings.HasSuffix(s, "."), Equals, true)
+		c.Check(len(strings.Fields(s)), Equals, n)
+	}
+}
+
+func (*TestSuite) BenchmarkSentence(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.Sentence(10)
+	}
+}
+
+func (*TestSuite) TestParagraph(c *C) {
+	var s string
+	var err error
+
+	_, err = securerandom.Paragraph(0)
+	c.Check(err, NotNil)
+
+	s, err = securerandom.Paragraph(5)
+	c.Assert(err, IsNil)
+	c.Check(unicode.IsUpper(rune(s[0])), Equals, true)
+	c.Check(strings.HasSuffix(s, "."), Equals, true)
+	c.Check(strings.Count(s, "."), Equals, 5)
+}
+
+func (*TestSuite) BenchmarkParagraph(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.Paragraph(5)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "errors"
+
+// MutateBits is a function that returns a copy of data with exactly the
+// number of distinct, randomly-chosen, bits specified by flips toggled. This is
+// useful for mutation-based fuzzing. The value of flips must be in the range
+// [0, 8*len(data)]. The input slice is not modified.
+func MutateBits(data []byte, flips int) ([]byte, error) {
+	if flips < 0 || flips > 8*len(data) {
+		return nil, errors.New("flips must be in the range [0, 8*len(data)]")
+	}
+
+	positions, err := sampleIndices(8*len(data), flips)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := append([]byte(nil), data...)
+
+	for _, pos := range positions {
+		out[pos/8] ^= 1 << uint(pos%8)
+	}
+
+	return out, nil
+}
+
+// MutationStrategy is a way of mutating data with Mutate.
+type MutationStrategy int
+
+const (
+	// FlipByte replaces a random byte with a different random value.
+	FlipByte MutationStrategy = iota
+
+	// InsertByte inserts a random byte at a random position.
+	InsertByte
+
+	// DeleteByte removes the byte at a random position.
+	DeleteByte
+
+	// DuplicateChunk copies a random chunk of the data, and inserts the copy
+	// immediately after the original.
+	DuplicateChunk
+
+	// SwapChunks exchanges two random, non-overlapping, chunks of the same
+	// length.
+	SwapChunks
+)
+
+// Mutate is a function that returns a copy of data that has been mutated
+// using the strategy provided, at random positions. This, along with
+// MutateBits, is a classic fuzzing mutator toolkit. The input slice is not
+// modified. An error is returned if the strategy is unknown, or if data is too
+// short for it: every strategy except InsertByte needs at least one byte, and
+// SwapChunks needs at least two.
+func Mutate(data []byte, strategy MutationStrategy) ([]byte, error) {
+	switch strategy {
+	case FlipByte:
+		return flipByte(data)
+	case InsertByte:
+		return insertByte(data)
+	case DeleteByte:
+		return deleteByte(data)
+	case DuplicateChunk:
+		return duplicateChunk(data)
+	case SwapChunks:
+		return swapChunks(data)
+	default:
+		return nil, errors.New("unknown mutation strategy")
+	}
+}
+
+// errDataTooShort is returned by Mutate when the data is too short for the
+// chosen strategy.
+var errDataTooShort = errors.New("data is too short for the mutation strategy")
+
+// flipByte is a function that implements the FlipByte mutation strategy.
+func flipByte(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errDataTooShort
+	}
+
+	pos, err := intn(len(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	// XOR-ing with a non-zero value guarantees the byte changes
+	x, err := intn(255)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := append([]byte(nil), data...)
+	out[pos] ^= byte(x + 1)
+
+	return out, nil
+}
+
+// insertByte is a function that implements the InsertByte mutation strategy.
+func insertByte(data []byte) ([]byte, error) {
+	pos, err := intn(len(data) + 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := Bytes(1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(data)+1)
+	out = append(out, data[:pos]...)
+	out = append(out, b[0])
+
+	return append(out, data[pos:]...), nil
+}
+
+// deleteByte is a function that implements the DeleteByte mutation strategy.
+func deleteByte(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errDataTooShort
+	}
+
+	pos, err := intn(len(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(data)-1)
+	out = append(out, data[:pos]...)
+
+	return append(out, data[pos+1:]...), nil
+}
+
+// duplicateChunk is a function that implements the DuplicateChunk mutation strategy.
+func duplicateChunk(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, errDataTooShort
+	}
+
+	start, err := intn(len(data))
+
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := intn(len(data) - start)
+
+	if err != nil {
+		return nil, err
+	}
+
+	end := start + n + 1
+
+	out := make([]byte, 0, len(data)+end-start)
+	out = append(out, data[:end]...)
+	out = append(out, data[start:end]...)
+
+	return append(out, data[end:]...), nil
+}
+
+// swapChunks is a function that implements the SwapChunks mutation strategy.
+func swapChunks(data []byte) ([]byte, error) {
+	if len(data) < 2 {
+		return nil, errDataTooShort
+	}
+
+	n, err := intn(len(data) / 2)
+
+	if err != nil {
+		return nil, err
+	}
+
+	size := n + 1
+
+	// the first chunk starts anywhere that leaves room for the second
+	i, err := intn(len(data) - 2*size + 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := intn(len(data) - size - (i + size) + 1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	j += i + size
+
+	out := append([]byte(nil), data...)
+	copy(out[i:i+size], data[j:j+size])
+	copy(out[j:j+size], data[i:i+size])
+
+	return out, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// hammingDistance is a function that returns the number of bits that differ
+// between two slices of the same length.
+func hammingDistance(a, b []byte) int {
+	x := make([]byte, len(a))
+
+	for i := range a {
+		x[i] = a[i] ^ b[i]
+	}
+
+	return popcount(x)
+}
+
+func (*TestSuite) TestMutateBits(c *C) {
+	var out []byte
+	var err error
+
+	data := []byte("fuzz me")
+
+	_, err = securerandom.MutateBits(data, -1)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.MutateBits(data, 8*len(data)+1)
+	c.Check(err, NotNil)
+
+	out, err = securerandom.MutateBits(nil, 0)
+	c.Assert(err, IsNil)
+	c.Check(len(out), Equals, 0)
+
+	for flips := 0; flips <= 8*len(data); flips += 7 {
+		out, err = securerandom.MutateBits(data, flips)
+		c.Assert(err, IsNil)
+		c.Assert(len(out), Equals, len(data))
+		c.Check(hammingDistance(data, out), Equals, flips)
+	}
+
+	out, err = securerandom.MutateBits(data, 8*len(data))
+	c.Assert(err, IsNil)
+	c.Check(hammingDistance(data, out), Equals, 8*len(data))
+
+	// the input must be left untouched
+	c.Check(string(data), Equals, "fuzz me")
+}
+
+func (*TestSuite) BenchmarkMutateBits(c *C) {
+	data := make([]byte, 64)
+
+	for i := 0; i < c.N; i++ {
+		securerandom.MutateBits(data, 8)
+	}
+}
+
+func (*TestSuite) TestMutate(c *C) {
+	var out []byte
+	var err error
+
+	data := []byte("abcdefghij")
+
+	_, err = securerandom.Mutate(data, securerandom.MutationStrategy(-1))
+	c.Check(err, NotNil)
+
+	_, err = securerandom.Mutate(data, securerandom.SwapChunks+1)
+	c.Check(err, NotNil)
+
+	for _, s := range []securerandom.MutationStrategy{securerandom.FlipByte, securerandom.DeleteByte, securerandom.DuplicateChunk, securerandom.SwapChunks} {
+		_, err = securerandom.Mutate(nil, s)
+		c.Check(err, NotNil)
+	}
+
+	_, err = securerandom.Mutate([]byte{1}, securerandom.SwapChunks)
+	c.Check(err, NotNil)
+
+	out, err = securerandom.Mutate(nil, securerandom.InsertByte)
+	c.Assert(err, IsNil)
+	c.Check(len(out), Equals, 1)
+
+	for i := 0; i < 100; i++ {
+		// FlipByte changes exactly one byte
+		out, err = securerandom.Mutate(data, securerandom.FlipByte)
+		c.Assert(err, IsNil)
+		c.Assert(len(out), Equals, len(data))
+
+		var diff int
+
+		for j := range data {
+			if data[j] != out[j] {
+				diff++
+			}
+		}
+
+		c.Assert(diff, Equals, 1)
+
+		// InsertByte adds one byte, without disturbing the rest
+		out, err = securerandom.Mutate(data, securerandom.InsertByte)
+		c.Assert(err, IsNil)
+		c.Assert(len(out), Equals, len(data)+1)
+		c.Assert(removesTo(out, data), Equals, true, Commentf("output: %q", out))
+
+		// DeleteByte removes one byte, without disturbing the rest
+		out, err = securerandom.Mutate(data, securerandom.DeleteByte)
+		c.Assert(err, IsNil)
+		c.Assert(len(out), Equals, len(data)-1)
+		c.Assert(removesTo(data, out), Equals, true, Commentf("output: %q", out))
+
+		// DuplicateChunk repeats a chunk immediately after itself
+		out, err = securerandom.Mutate(data, securerandom.DuplicateChunk)
+		c.Assert(err, IsNil)
+		c.Assert(isDuplicated(data, out), Equals, true, Commentf("output: %q", out))
+
+		// SwapChunks exchanges two equally-sized chunks
+		out, err = securerandom.Mutate(data, securerandom.SwapChunks)
+		c.Assert(err, IsNil)
+		c.Assert(isSwapped(data, out), Equals, true, Commentf("output: %q", out))
+	}
+
+	// the input must be left untouched
+	c.Check(string(data), Equals, "abcdefghij")
+}
+
+// removesTo is a function that returns whether removing a single byte from
+// long yields short.
+func removesTo(long, short []byte) bool {
+	for k := range long {
+		if string(long[:k])+string(long[k+1:]) == string(short) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDuplicated is a function that returns whether out is data with one of its
+// chunks repeated immediately after itself.
+func isDuplicated(data, out []byte) bool {
+	for start := 0; start < len(data); start++ {
+		for end := start + 1; end <= len(data); end++ {
+			if string(data[:end])+string(data[start:end])+string(data[end:]) == string(out) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isSwapped is a function that returns whether out is data with two of its
+// non-overlapping, equally-sized, chunks exchanged.
+func isSwapped(data, out []byte) bool {
+	for size := 1; 2*size <= len(data); size++ {
+		for i := 0; i+2*size <= len(data); i++ {
+			for j := i + size; j+size <= len(data); j++ {
+				s := string(data[:i]) + string(data[j:j+size]) + string(data[i+size:j]) + string(data[i:i+size]) + string(data[j+size:])
+
+				if s == string(out) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (*TestSuite) BenchmarkMutate(c *C) {
+	data := make([]byte, 64)
+
+	for i := 0; i < c.N; i++ {
+		securerandom.Mutate(data, securerandom.SwapChunks)
+	}
+}
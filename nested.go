@@ -0,0 +1,99 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"math"
+)
+
+// maxNestedKeys is the most keys in each map generated by RandomNestedMap.
+const maxNestedKeys = 5
+
+// RandomNestedMap is a function that returns a randomly-shaped nested map,
+// similar to decoded JSON, for fuzzing. Each map has between 1 and 5 keys,
+// taken from the lorem-ipsum word list. A value in a map at depth d (the
+// returned map being depth 1) is itself a map with probability branchProb^d,
+// otherwise it's a random string, float64, int64, or bool. As the branching
+// probability decays with depth, the trees are usually shallow but are
+// occasionally deep. No map is ever nested deeper than maxDepth.
+//
+// The value of maxDepth must be at least 1, and branchProb must be in the range
+// [0, 1].
+func RandomNestedMap(maxDepth int, branchProb float64) (map[string]interface{}, error) {
+	if maxDepth < 1 {
+		return nil, errors.New("maxDepth must be at least 1")
+	}
+
+	if !(branchProb >= 0 && branchProb <= 1) {
+		return nil, errors.New("branchProb must be in the range [0, 1]")
+	}
+
+	return nestedMap(1, maxDepth, branchProb)
+}
+
+// nestedMap is a function that generates a single map at the given depth for
+// RandomNestedMap, recursing to create any nested maps.
+func nestedMap(depth, maxDepth int, branchProb float64) (map[string]interface{}, error) {
+	n, err := intn(maxNestedKeys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := sampleIndices(len(loremWords), n+1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]interface{}, len(keys))
+	p := math.Pow(branchProb, float64(depth))
+
+	for _, k := range keys {
+		var v interface{}
+
+		f, err := float64n()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if depth < maxDepth && f < p {
+			v, err = nestedMap(depth+1, maxDepth, branchProb)
+		} else {
+			v, err = nestedScalar()
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		m[loremWords[k]] = v
+	}
+
+	return m, nil
+}
+
+// nestedScalar is a function that returns a random leaf value for
+// RandomNestedMap.
+func nestedScalar() (interface{}, error) {
+	kind, err := intn(4)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case 0:
+		return URLBase64OfBytes(fillStringBytes)
+	case 1:
+		return float64n()
+	case 2:
+		return Int64()
+	default:
+		return Bool()
+	}
+}
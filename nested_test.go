@@ -0,0 +1,72 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// mapDepth is a function that returns how deeply nested m is, with a map that
+// contains no other maps having a depth of 1.
+func mapDepth(m map[string]interface{}) int {
+	max := 0
+
+	for _, v := range m {
+		if nested, ok := v.(map[string]interface{}); ok {
+			if d := mapDepth(nested); d > max {
+				max = d
+			}
+		}
+	}
+
+	return max + 1
+}
+
+func (*TestSuite) TestRandomNestedMap(c *C) {
+	var m map[string]interface{}
+	var err error
+
+	_, err = securerandom.RandomNestedMap(0, 0.5)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RandomNestedMap(3, -0.1)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RandomNestedMap(3, 1.1)
+	c.Check(err, NotNil)
+
+	m, err = securerandom.RandomNestedMap(5, 0)
+	c.Assert(err, IsNil)
+	c.Check(len(m) > 0, Equals, true)
+	c.Check(mapDepth(m), Equals, 1)
+
+	// with a branch probability of 1, every value is a map until the
+	// maximum depth is reached
+	m, err = securerandom.RandomNestedMap(3, 1)
+	c.Assert(err, IsNil)
+	c.Check(mapDepth(m), Equals, 3)
+
+	depths := make(map[int]struct{})
+
+	for i := 0; i < 200; i++ {
+		m, err = securerandom.RandomNestedMap(6, 0.8)
+		c.Assert(err, IsNil)
+		c.Assert(len(m) > 0, Equals, true)
+
+		d := mapDepth(m)
+		c.Assert(d <= 6, Equals, true)
+		depths[d] = struct{}{}
+	}
+
+	c.Check(len(depths) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkRandomNestedMap(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomNestedMap(4, 0.5)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// reservedTLDs are the top-level domains reserved by RFC 2606 for testing and
+// documentation, so generated names can never collide with real ones.
+var reservedTLDs = []string{"test", "example", "invalid", "localhost"}
+
+// hostWords is the word list used to build host names and URL paths.
+var hostWords = []string{
+	"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel",
+	"india", "juliet", "kilo", "lima", "mike", "november", "oscar", "papa",
+	"quebec", "romeo", "sierra", "tango", "uniform", "victor", "whiskey",
+	"xray", "yankee", "zulu",
+}
+
+const (
+	// labelAlnum are the characters allowed anywhere in a hostname label.
+	labelAlnum = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	// labelChars are the characters allowed within the interior of a hostname
+	// label, which can't start or end with a hyphen.
+	labelChars = labelAlnum + "-"
+
+	// maxLabelLength is the longest a hostname label may be.
+	maxLabelLength = 63
+
+	// maxHostnameLength is the longest a full hostname may be.
+	maxHostnameLength = 253
+
+	// maxHostnameLabels is the most labels RandomHostname will generate,
+	// not counting the top-level domain.
+	maxHostnameLabels = 4
+)
+
+// RandomURL is a function that returns a syntactically valid random URL. The
+// scheme is either http or https, the host is built from a word list under one
+// of the reserved top-level domains (e.g., "example"), and the URL has a random
+// number of path segments and optional query parameters.
+func RandomURL() (string, error) {
+	scheme, err := pick([]string{"http", "https"})
+
+	if err != nil {
+		return "", err
+	}
+
+	word, err := pick(hostWords)
+
+	if err != nil {
+		return "", err
+	}
+
+	tld, err := pick(reservedTLDs)
+
+	if err != nil {
+		return "", err
+	}
+
+	u := url.URL{Scheme: scheme, Host: word + "." + tld, Path: "/"}
+
+	segments, err := intn(4)
+
+	if err != nil {
+		return "", err
+	}
+
+	path := make([]string, segments)
+
+	for i := range path {
+		if path[i], err = pick(hostWords); err != nil {
+			return "", err
+		}
+	}
+
+	u.Path += strings.Join(path, "/")
+
+	params, err := intn(3)
+
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+
+	for i := 0; i < params; i++ {
+		key, err := pick(hostWords)
+
+		if err != nil {
+			return "", err
+		}
+
+		val, err := Uint16()
+
+		if err != nil {
+			return "", err
+		}
+
+		q.Add(key, strconv.Itoa(int(val)))
+	}
+
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// RandomMACWithOUI is a function that returns a MAC address whose first three
+// octets are the Organizationally Unique Identifier provided, and whose last
+// three octets are random. This is useful for generating addresses that appear
+// to belong to a specific vendor.
+func RandomMACWithOUI(oui [3]byte) (net.HardwareAddr, error) {
+	b, err := Bytes(3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return net.HardwareAddr{oui[0], oui[1], oui[2], b[0], b[1], b[2]}, nil
+}
+
+// RandomHostname is a function that returns a syntactically valid hostname
+// with a random number of labels under one of the reserved top-level domains
+// (e.g., "test"). Each label is 1 to 63 characters of lowercase letters,
+// digits, and hyphens, and never starts or ends with a hyphen. The full
+// hostname is never longer than 253 characters.
+func RandomHostname() (string, error) {
+	tld, err := pick(reservedTLDs)
+
+	if err != nil {
+		return "", err
+	}
+
+	n, err := intn(maxHostnameLabels)
+
+	if err != nil {
+		return "", err
+	}
+
+	labels := make([]string, 0, n+2)
+	budget := maxHostnameLength - len(tld)
+
+	for i := 0; i <= n; i++ {
+		// leave room for the dot separating this label from the next
+		max := budget - 1
+
+		if max > maxLabelLength {
+			max = maxLabelLength
+		}
+
+		if max < 1 {
+			break
+		}
+
+		l, err := intn(max)
+
+		if err != nil {
+			return "", err
+		}
+
+		label, err := hostnameLabel(l + 1)
+
+		if err != nil {
+			return "", err
+		}
+
+		labels = append(labels, label)
+		budget -= len(label) + 1
+	}
+
+	return strings.Join(append(labels, tld), "."), nil
+}
+
+// hostnameLabel is a function that returns a random hostname label of length
+// n, which doesn't start or end with a hyphen.
+func hostnameLabel(n int) (string, error) {
+	b := make([]byte, n)
+
+	for i := range b {
+		chars := labelChars
+
+		if i == 0 || i == n-1 {
+			chars = labelAlnum
+		}
+
+		j, err := intn(len(chars))
+
+		if err != nil {
+			return "", err
+		}
+
+		b[i] = chars[j]
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"bytes"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// hasReservedTLD is a function that returns whether the host ends in one of the
+// top-level domains reserved by RFC 2606.
+func hasReservedTLD(host string) bool {
+	for _, tld := range []string{".test", ".example", ".invalid", ".localhost"} {
+		if strings.HasSuffix(host, tld) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (*TestSuite) TestRandomURL(c *C) {
+	var s string
+	var u *url.URL
+	var err error
+
+	for i := 0; i < 100; i++ {
+		s, err = securerandom.RandomURL()
+		c.Assert(err, IsNil)
+
+		u, err = url.Parse(s)
+		c.Assert(err, IsNil)
+		c.Check(u.Scheme == "http" || u.Scheme == "https", Equals, true)
+		c.Check(hasReservedTLD(u.Hostname()), Equals, true, Commentf("host: %s", u.Host))
+
+		_, err = url.ParseQuery(u.RawQuery)
+		c.Check(err, IsNil)
+	}
+}
+
+func (*TestSuite) BenchmarkRandomURL(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomURL()
+	}
+}
+
+func (*TestSuite) TestRandomMACWithOUI(c *C) {
+	var mac net.HardwareAddr
+	var err error
+
+	oui := [3]byte{0x00, 0x1b, 0x63}
+	suffixes := make(map[string]struct{})
+
+	for i := 0; i < 20; i++ {
+		mac, err = securerandom.RandomMACWithOUI(oui)
+		c.Assert(err, IsNil)
+		c.Assert(len(mac), Equals, 6)
+		c.Check(bytes.Equal(mac[:3], oui[:]), Equals, true)
+
+		suffixes[string(mac[3:])] = struct{}{}
+	}
+
+	c.Check(len(suffixes) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkRandomMACWithOUI(c *C) {
+	oui := [3]byte{0x00, 0x1b, 0x63}
+
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomMACWithOUI(oui)
+		c.SetBytes(3)
+	}
+}
+
+// labelRegexp matches a single valid hostname label.
+var labelRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// validHostname is a function that strictly validates a hostname.
+func validHostname(host string) bool {
+	if len(host) == 0 || len(host) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if !labelRegexp.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (*TestSuite) TestRandomHostname(c *C) {
+	var s string
+	var err error
+
+	c.Check(validHostname("a.test"), Equals, true)
+	c.Check(validHostname("-a.test"), Equals, false)
+	c.Check(validHostname("a-.test"), Equals, false)
+	c.Check(validHostname("a..test"), Equals, false)
+	c.Check(validHostname(strings.Repeat("a", 64)+".test"), Equals, false)
+
+	labels := make(map[int]struct{})
+
+	for i := 0; i < 500; i++ {
+		s, err = securerandom.RandomHostname()
+		c.Assert(err, IsNil)
+		c.Check(validHostname(s), Equals, true, Commentf("hostname: %s", s))
+		c.Check(hasReservedTLD(s), Equals, true, Commentf("hostname: %s", s))
+
+		labels[strings.Count(s, ".")] = struct{}{}
+	}
+
+	c.Check(len(labels) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkRandomHostname(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomHostname()
+	}
+}
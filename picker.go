@@ -0,0 +1,144 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"sync"
+)
+
+// NoRepeatPicker is a picker that never returns an item that was returned
+// within the last few picks, which is useful for things like media playlists.
+// Each pick is drawn uniformly from the items that are eligible. It's safe for
+// concurrent use by multiple goroutines.
+//
+// Use NewNoRepeatPicker to create one.
+type NoRepeatPicker[T comparable] struct {
+	mu     sync.Mutex
+	items  []T
+	recent []T
+	window int
+}
+
+// NewNoRepeatPicker is a function that returns a NoRepeatPicker that chooses
+// from the items provided, and never returns an item that was returned within
+// the last windowSize picks. Duplicate items are only counted once. The value
+// of windowSize must not be negative, and must be less than the number of
+// distinct items so there's always an item eligible to be picked.
+func NewNoRepeatPicker[T comparable](items []T, windowSize int) (*NoRepeatPicker[T], error) {
+	if windowSize < 0 {
+		return nil, errors.New("windowSize must not be negative")
+	}
+
+	seen := make(map[T]struct{}, len(items))
+	distinct := make([]T, 0, len(items))
+
+	for _, item := range items {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+
+		seen[item] = struct{}{}
+		distinct = append(distinct, item)
+	}
+
+	if windowSize >= len(distinct) {
+		return nil, errors.New("windowSize must be less than the number of distinct items")
+	}
+
+	return &NoRepeatPicker[T]{
+		items:  distinct,
+		recent: make([]T, 0, windowSize),
+		window: windowSize,
+	}, nil
+}
+
+// Pick is a method that returns an item chosen uniformly from those that
+// weren't returned within the last windowSize picks.
+func (p *NoRepeatPicker[T]) Pick() (T, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	excluded := make(map[T]struct{}, len(p.recent))
+
+	for _, item := range p.recent {
+		excluded[item] = struct{}{}
+	}
+
+	eligible := make([]T, 0, len(p.items)-len(excluded))
+
+	for _, item := range p.items {
+		if _, ok := excluded[item]; !ok {
+			eligible = append(eligible, item)
+		}
+	}
+
+	item, err := pick(eligible)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	if p.window > 0 {
+		if len(p.recent) == p.window {
+			p.recent = append(p.recent[:0], p.recent[1:]...)
+		}
+
+		p.recent = append(p.recent, item)
+	}
+
+	return item, nil
+}
+
+// CyclicPicker is a picker that returns items in a random order, and once all
+// of them have been returned reshuffles them and starts a new cycle. This
+// guarantees that no item repeats until a full cycle is complete, like the
+// shuffled playback of a music player. It's safe for concurrent use by
+// multiple goroutines.
+//
+// Use NewCyclicPicker to create one.
+type CyclicPicker[T any] struct {
+	mu    sync.Mutex
+	order []T
+	pos   int
+}
+
+// NewCyclicPicker is a function that returns a CyclicPicker that cycles through
+// the items provided. The items are copied, so later changes to the slice have
+// no effect. There must be at least one item.
+func NewCyclicPicker[T any](items []T) (*CyclicPicker[T], error) {
+	if len(items) == 0 {
+		return nil, errors.New("items must not be empty")
+	}
+
+	// start at the end so the first call to Next shuffles
+	order := append([]T(nil), items...)
+
+	return &CyclicPicker[T]{order: order, pos: len(order)}, nil
+}
+
+// Next is a method that returns the next item in the current cycle, starting
+// a new independently-shuffled cycle if the current one is complete.
+func (p *CyclicPicker[T]) Next() (T, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.pos == len(p.order) {
+		err := shuffle(len(p.order), func(i, j int) { p.order[i], p.order[j] = p.order[j], p.order[i] })
+
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+
+		p.pos = 0
+	}
+
+	v := p.order[p.pos]
+	p.pos++
+
+	return v, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestNoRepeatPicker(c *C) {
+	var p *securerandom.NoRepeatPicker[int]
+	var v int
+	var err error
+
+	_, err = securerandom.NewNoRepeatPicker([]int{1, 2, 3}, -1)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.NewNoRepeatPicker([]int{1, 2, 3}, 3)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.NewNoRepeatPicker([]int{1, 1, 2}, 2)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.NewNoRepeatPicker([]int{}, 0)
+	c.Check(err, NotNil)
+
+	const window = 3
+
+	p, err = securerandom.NewNoRepeatPicker([]int{1, 2, 3, 4, 5}, window)
+	c.Assert(err, IsNil)
+
+	var history []int
+
+	seen := make(map[int]struct{})
+
+	for i := 0; i < 1000; i++ {
+		v, err = p.Pick()
+		c.Assert(err, IsNil)
+
+		start := len(history) - window
+
+		if start < 0 {
+			start = 0
+		}
+
+		for _, prev := range history[start:] {
+			c.Assert(v, Not(Equals), prev)
+		}
+
+		history = append(history, v)
+		seen[v] = struct{}{}
+	}
+
+	c.Check(len(seen), Equals, 5)
+}
+
+func (*TestSuite) BenchmarkNoRepeatPickerPick(c *C) {
+	p, _ := securerandom.NewNoRepeatPicker([]int{1, 2, 3, 4, 5, 6, 7, 8}, 4)
+
+	for i := 0; i < c.N; i++ {
+		p.Pick()
+	}
+}
+
+func (*TestSuite) TestCyclicPicker(c *C) {
+	var p *securerandom.CyclicPicker[string]
+	var v string
+	var err error
+
+	_, err = securerandom.NewCyclicPicker([]string{})
+	c.Check(err, NotNil)
+
+	items := []string{"a", "b", "c", "d", "e", "f"}
+
+	// cycle returns the next len(items) picks, and checks that each item
+	// appears exactly once
+	cycle := func() string {
+		seen := make(map[string]int)
+
+		var order string
+
+		for range items {
+			v, err = p.Next()
+			c.Assert(err, IsNil)
+			seen[v]++
+			order += v
+		}
+
+		for _, item := range items {
+			c.Assert(seen[item], Equals, 1)
+		}
+
+		return order
+	}
+
+	var differ int
+
+	for i := 0; i < 20; i++ {
+		p, err = securerandom.NewCyclicPicker(items)
+		c.Assert(err, IsNil)
+
+		if cycle() != cycle() {
+			differ++
+		}
+	}
+
+	// with 720 possible orders the cycles should almost always differ
+	c.Check(differ > 10, Equals, true)
+}
+
+func (*TestSuite) BenchmarkCyclicPickerNext(c *C) {
+	p, _ := securerandom.NewCyclicPicker([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	for i := 0; i < c.N; i++ {
+		p.Next()
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "strconv"
+
+// quantitySuffixes are the binary (power of two) and decimal SI suffixes used
+// by RandomQuantity. The empty suffix is a plain number.
+var quantitySuffixes = []string{
+	"Ki", "Mi", "Gi", "Ti", "Pi", "Ei",
+	"m", "", "k", "M", "G", "T", "P", "E",
+}
+
+// RandomQuantity is a function that returns a random quantity with a random
+// binary or SI suffix, such as "512Ki", "3.2M", or "750m". The value is between
+// 1 and 1000, and half of the time has a single decimal place. This is useful
+// for exercising quantity parsers like the one used for Kubernetes resources.
+func RandomQuantity() (string, error) {
+	n, err := intn(1000)
+
+	if err != nil {
+		return "", err
+	}
+
+	s := strconv.Itoa(n + 1)
+
+	frac, err := Bool()
+
+	if err != nil {
+		return "", err
+	}
+
+	if frac {
+		d, err := intn(10)
+
+		if err != nil {
+			return "", err
+		}
+
+		s += "." + strconv.Itoa(d)
+	}
+
+	suffix, err := pick(quantitySuffixes)
+
+	if err != nil {
+		return "", err
+	}
+
+	return s + suffix, nil
+}
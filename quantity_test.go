@@ -0,0 +1,75 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+// quantityRegexp matches a quantity with an optional binary or SI suffix,
+// using the same grammar as Kubernetes' resource.Quantity.
+var quantityRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)(Ki|Mi|Gi|Ti|Pi|Ei|m|k|M|G|T|P|E)?$`)
+
+// quantityMultipliers are the values of each suffix.
+var quantityMultipliers = map[string]float64{
+	"Ki": 1 << 10, "Mi": 1 << 20, "Gi": 1 << 30, "Ti": 1 << 40, "Pi": 1 << 50, "Ei": 1 << 60,
+	"m": 1e-3, "": 1, "k": 1e3, "M": 1e6, "G": 1e9, "T": 1e12, "P": 1e15, "E": 1e18,
+}
+
+// parseQuantity is a function that parses a quantity into its value.
+func parseQuantity(s string) (float64, string, bool) {
+	m := quantityRegexp.FindStringSubmatch(s)
+
+	if m == nil {
+		return 0, "", false
+	}
+
+	f, err := strconv.ParseFloat(m[1], 64)
+
+	if err != nil {
+		return 0, "", false
+	}
+
+	return f * quantityMultipliers[m[2]], m[2], true
+}
+
+func (*TestSuite) TestRandomQuantity(c *C) {
+	var s string
+	var err error
+
+	_, _, ok := parseQuantity("1.5Qi")
+	c.Check(ok, Equals, false)
+
+	v, _, ok := parseQuantity("512Ki")
+	c.Check(ok, Equals, true)
+	c.Check(v, Equals, 524288.0)
+
+	suffixes := make(map[string]struct{})
+
+	for i := 0; i < 500; i++ {
+		s, err = securerandom.RandomQuantity()
+		c.Assert(err, IsNil)
+
+		v, suffix, ok := parseQuantity(s)
+		c.Assert(ok, Equals, true, Commentf("quantity: %s", s))
+		c.Assert(v > 0 && !math.IsInf(v, 0), Equals, true)
+
+		suffixes[suffix] = struct{}{}
+	}
+
+	c.Check(len(suffixes) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkRandomQuantity(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomQuantity()
+	}
+}
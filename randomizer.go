@@ -0,0 +1,93 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+)
+
+// Randomizer generates random data from a source of its own, rather than
+// crypto/rand directly like the rest of this package. This allows the
+// randomness used in a session to be recorded with RecordingReader and later
+// replayed with ReplayReader. It's safe for concurrent use by multiple
+// goroutines, as each draw reads from the source atomically.
+//
+// Use New to create one.
+type Randomizer struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+// New is a function that returns a Randomizer that draws its random data from
+// r. If r is nil, crypto/rand is used. Only pass a reader other than one from
+// crypto/rand or RecordingReader when the output is not relied upon for
+// security, as the values generated are only as unpredictable as r.
+func New(r io.Reader) *Randomizer {
+	if r == nil {
+		r = crand.Reader
+	}
+
+	return &Randomizer{r: r}
+}
+
+// Bytes is a method that returns a slice of length n containing random bytes.
+func (rz *Randomizer) Bytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, errors.New("n must not be negative")
+	}
+
+	b := make([]byte, n)
+
+	rz.mu.Lock()
+	defer rz.mu.Unlock()
+
+	if _, err := io.ReadFull(rz.r, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Uint64 is a method that returns a uint64 generated from 8 random bytes.
+func (rz *Randomizer) Uint64() (uint64, error) {
+	b, err := rz.Bytes(8)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// Int64 is a method that returns an int64 generated from 8 random bytes.
+func (rz *Randomizer) Int64() (int64, error) {
+	u64, err := rz.Uint64()
+	return int64(u64), err
+}
+
+// Intn is a method that returns a uniformly distributed int in the half-open
+// interval [0, n). This can be used to choose an element from a slice. The
+// value of n must be greater than zero.
+func (rz *Randomizer) Intn(n int) (int, error) {
+	if n <= 0 {
+		return 0, errors.New("n must be greater than zero")
+	}
+
+	rz.mu.Lock()
+	defer rz.mu.Unlock()
+
+	bi, err := crand.Int(rz.r, big.NewInt(int64(n)))
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(bi.Int64()), nil
+}
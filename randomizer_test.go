@@ -0,0 +1,66 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"bytes"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestRandomizer(c *C) {
+	var rz *securerandom.Randomizer
+	var b []byte
+	var n int
+	var err error
+
+	// a nil reader falls back to crypto/rand
+	rz = securerandom.New(nil)
+
+	b, err = rz.Bytes(16)
+	c.Assert(err, IsNil)
+	c.Check(len(b), Equals, 16)
+
+	_, err = rz.Bytes(-1)
+	c.Check(err, NotNil)
+
+	_, err = rz.Intn(0)
+	c.Check(err, NotNil)
+
+	seen := make(map[int]struct{})
+
+	for i := 0; i < 200; i++ {
+		n, err = rz.Intn(4)
+		c.Assert(err, IsNil)
+		c.Assert(n >= 0 && n < 4, Equals, true)
+		seen[n] = struct{}{}
+	}
+
+	c.Check(len(seen), Equals, 4)
+
+	// values are decoded big-endian, like the package's Int64
+	rz = securerandom.New(bytes.NewReader([]byte{0x80, 0, 0, 0, 0, 0, 0, 0x01}))
+
+	var i64 int64
+
+	i64, err = rz.Int64()
+	c.Assert(err, IsNil)
+	c.Check(i64, Equals, int64(-1<<63+1))
+
+	// running out of data is an error
+	_, err = rz.Int64()
+	c.Check(err, NotNil)
+}
+
+func (*TestSuite) BenchmarkRandomizerInt64(c *C) {
+	rz := securerandom.New(nil)
+
+	for i := 0; i < c.N; i++ {
+		rz.Int64()
+		c.SetBytes(8)
+	}
+}
@@ -35,11 +35,24 @@
 //		rStr, _ = securerandom.Base64InBytes(32)
 //
 // 		fmt.Println(rStr) // would print Base64 string with a length of 32
+//
+// The functions in this package are safe for concurrent use by multiple
+// goroutines, and may share read-only arguments. However, BlockShuffle,
+// ShuffleTracked, and Unshuffle modify the slice they're given, so a slice
+// passed to one of them must not be used by any other goroutine at the same
+// time. The methods of BoolSource, AdaptivePicker, NoRepeatPicker, CyclicPicker,
+// FailureInjector, StateMachine, and Randomizer are also safe for concurrent
+// use. Values returned by the package are not necessarily safe, though: the
+// Source from RandSource isn't, and the reader from ChunkedReader is only as
+// safe as the reader it wraps.
 package securerandom
 
 import (
 	crand "crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/big"
 	"math/rand"
 )
 
@@ -96,6 +109,24 @@ func URLBase64OfBytes(n int) (string, error) {
 	return base64.URLEncoding.EncodeToString(b), err
 }
 
+// HexDump is a function that returns n random bytes formatted exactly as
+// hex.Dump would: each line has the offset, sixteen hex columns, and an ASCII
+// gutter. This is useful for eyeballing random data in test logs. The value of
+// n must not be negative.
+func HexDump(n int) (string, error) {
+	if n < 0 {
+		return "", errors.New("n must not be negative")
+	}
+
+	b, err := Bytes(n)
+
+	if err != nil {
+		return "", err
+	}
+
+	return hex.Dump(b), nil
+}
+
 // Uint16 is a function that returns a uint16 generated by
 // 'bitwise-or'ing 4 bytes from crypto/rand.Read().
 func Uint16() (uint16, error) {
@@ -216,6 +247,19 @@ func Int64() (int64, error) {
 	return i64, nil
 }
 
+// Bool is a function that returns a random bool, generated from a single
+// byte from crypto/rand.Read(). If you need a lot of bools, BoolSource wastes
+// fewer random bits.
+func Bool() (bool, error) {
+	b, err := Bytes(1)
+
+	if err != nil {
+		return false, err
+	}
+
+	return b[0]&1 == 1, nil
+}
+
 // RandSource is a function that returns a Source from the "math/rand" package
 // to be used to create a new pseudorandom generator. If this returns err != nil
 // the value of the source is not suitable for use.
@@ -228,3 +272,66 @@ func RandSource() (rand.Source, error) {
 
 	return rand.NewSource(randInt64), nil
 }
+
+// float64n is a function that returns a uniformly distributed float64 in the
+// half-open interval [0.0, 1.0). It uses the top 53 bits of a Uint64, as that's
+// the precision of a float64 mantissa.
+func float64n() (float64, error) {
+	u64, err := Uint64()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(u64>>11) / (1 << 53), nil
+}
+
+// intn is a function that returns a uniformly distributed int in the
+// half-open interval [0, n). The value of n must be greater than zero.
+func intn(n int) (int, error) {
+	i64, err := int64n(int64(n))
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int(i64), nil
+}
+
+// int64n is a function that returns a uniformly distributed int64 in the
+// half-open interval [0, n). The value of n must be greater than zero. Unlike
+// intn, it can draw from ranges wider than an int on 32-bit platforms.
+func int64n(n int64) (int64, error) {
+	bi, err := crand.Int(crand.Reader, big.NewInt(n))
+
+	if err != nil {
+		return 0, err
+	}
+
+	return bi.Int64(), nil
+}
+
+// sampleIndices is a function that returns k distinct indices chosen
+// uniformly from the half-open interval [0, n), using Robert Floyd's sampling
+// algorithm. The order of the returned indices is not itself random.
+func sampleIndices(n, k int) ([]int, error) {
+	seen := make(map[int]struct{}, k)
+	out := make([]int, 0, k)
+
+	for j := n - k; j < n; j++ {
+		t, err := intn(j + 1)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[t]; ok {
+			t = j
+		}
+
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+
+	return out, nil
+}
@@ -5,7 +5,9 @@
 package securerandom_test
 
 import (
+	"encoding/hex"
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/theckman/go-securerandom"
@@ -130,6 +132,47 @@ func (t *TestSuite) BenchmarkURLBase64OfBytes(c *C) {
 	}
 }
 
+func (*TestSuite) TestHexDump(c *C) {
+	var s string
+	var err error
+
+	_, err = securerandom.HexDump(-1)
+	c.Check(err, NotNil)
+
+	s, err = securerandom.HexDump(0)
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "")
+
+	s, err = securerandom.HexDump(45)
+	c.Assert(err, IsNil)
+
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	c.Assert(len(lines), Equals, 3)
+
+	// the hex columns sit between the offset and the ASCII gutter
+	var b []byte
+
+	for _, line := range lines {
+		cols := strings.Fields(line[10:58])
+
+		for _, col := range cols {
+			v, err := hex.DecodeString(col)
+			c.Assert(err, IsNil)
+			b = append(b, v...)
+		}
+	}
+
+	c.Assert(len(b), Equals, 45)
+	c.Check(s, Equals, hex.Dump(b))
+}
+
+func (t *TestSuite) BenchmarkHexDump(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.HexDump(64)
+		c.SetBytes(64)
+	}
+}
+
 func (t *TestSuite) TestUint16(c *C) {
 	var u16 uint16
 	var err error
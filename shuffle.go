@@ -0,0 +1,169 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "errors"
+
+// shuffle is a function that randomizes the order of n elements using
+// the Fisher-Yates algorithm, with swap swapping the elements with indexes i
+// and j.
+func shuffle(n int, swap func(i, j int)) error {
+	for i := n - 1; i > 0; i-- {
+		j, err := intn(i + 1)
+
+		if err != nil {
+			return err
+		}
+
+		swap(i, j)
+	}
+
+	return nil
+}
+
+// Interleave is a function that returns a random interleaving of a and b, in
+// which the elements of a keep their relative order, as do the elements of b.
+// This is a random shuffle-merge, and every such interleaving is equally
+// likely. The input slices are not modified.
+func Interleave[T any](a, b []T) ([]T, error) {
+	out := make([]T, 0, len(a)+len(b))
+
+	for len(a) > 0 && len(b) > 0 {
+		// take from a with probability proportional to how many
+		// elements a has remaining
+		n, err := intn(len(a) + len(b))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if n < len(a) {
+			out, a = append(out, a[0]), a[1:]
+		} else {
+			out, b = append(out, b[0]), b[1:]
+		}
+	}
+
+	out = append(out, a...)
+
+	return append(out, b...), nil
+}
+
+// BlockShuffle is a function that partitions s into consecutive blocks of
+// blockSize elements, and shuffles the order of those blocks in place. The
+// order of the elements within each block is preserved, which is useful for
+// shuffling grouped records. If the length of s isn't a multiple of blockSize,
+// the final block is shorter but is shuffled like any other. The value of
+// blockSize must be at least 1.
+func BlockShuffle[T any](s []T, blockSize int) error {
+	if blockSize < 1 {
+		return errors.New("blockSize must be at least 1")
+	}
+
+	blocks := make([][]T, 0, (len(s)+blockSize-1)/blockSize)
+
+	for i := 0; i < len(s); i += blockSize {
+		end := i + blockSize
+
+		if end > len(s) {
+			end = len(s)
+		}
+
+		blocks = append(blocks, s[i:end])
+	}
+
+	err := shuffle(len(blocks), func(i, j int) { blocks[i], blocks[j] = blocks[j], blocks[i] })
+
+	if err != nil {
+		return err
+	}
+
+	// the blocks alias s, so they must be copied out before writing back
+	out := make([]T, 0, len(s))
+
+	for _, b := range blocks {
+		out = append(out, b...)
+	}
+
+	copy(s, out)
+
+	return nil
+}
+
+// ShuffleTracked is a function that shuffles s in place, and returns the
+// permutation that was applied: the element now at position i was originally
+// at position perm[i]. This allows the shuffle to be logged for debugging, or
+// reversed later using Unshuffle.
+func ShuffleTracked[T any](s []T) ([]int, error) {
+	perm := make([]int, len(s))
+
+	for i := range perm {
+		perm[i] = i
+	}
+
+	err := shuffle(len(s), func(i, j int) {
+		s[i], s[j] = s[j], s[i]
+		perm[i], perm[j] = perm[j], perm[i]
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return perm, nil
+}
+
+// Unshuffle is a function that reverses, in place, a shuffle of s described by
+// perm, as returned by ShuffleTracked. An error is returned if perm isn't a
+// permutation of the indexes of s.
+func Unshuffle[T any](s []T, perm []int) error {
+	if len(perm) != len(s) {
+		return errors.New("perm must be the same length as s")
+	}
+
+	seen := make([]bool, len(perm))
+
+	for _, p := range perm {
+		if p < 0 || p >= len(perm) || seen[p] {
+			return errors.New("perm must be a permutation of the indexes of s")
+		}
+
+		seen[p] = true
+	}
+
+	orig := make([]T, len(s))
+
+	for i, p := range perm {
+		orig[p] = s[i]
+	}
+
+	copy(s, orig)
+
+	return nil
+}
+
+// ShuffledValues is a function that returns a copy of values in a random
+// order, leaving the input unmodified. It's intended for property testing that
+// needs to cover each value of an enum exactly once, in an unpredictable order:
+//
+//	states := []State{Pending, Running, Done, Failed}
+//
+//	order, err := securerandom.ShuffledValues(states)
+//
+//	// secure-random data is unavailable
+//	if err != nil { /* handle err */ }
+//
+//	for _, s := range order {
+//		// each state is visited exactly once
+//	}
+func ShuffledValues[T any](values []T) ([]T, error) {
+	out := append([]T(nil), values...)
+
+	if err := shuffle(len(out), func(i, j int) { out[i], out[j] = out[j], out[i] }); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestInterleave(c *C) {
+	var out []int
+	var err error
+
+	out, err = securerandom.Interleave([]int{}, []int{1, 2})
+	c.Assert(err, IsNil)
+	c.Check(out, DeepEquals, []int{1, 2})
+
+	out, err = securerandom.Interleave([]int{1, 2}, nil)
+	c.Assert(err, IsNil)
+	c.Check(out, DeepEquals, []int{1, 2})
+
+	a := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	b := []int{100, 101, 102, 103, 104}
+	shapes := make(map[string]struct{})
+
+	for i := 0; i < 100; i++ {
+		out, err = securerandom.Interleave(a, b)
+		c.Assert(err, IsNil)
+		c.Assert(len(out), Equals, len(a)+len(b))
+
+		var gotA, gotB []int
+		shape := make([]byte, len(out))
+
+		for j, v := range out {
+			if v < 100 {
+				gotA = append(gotA, v)
+				shape[j] = 'a'
+			} else {
+				gotB = append(gotB, v)
+				shape[j] = 'b'
+			}
+		}
+
+		c.Assert(gotA, DeepEquals, a)
+		c.Assert(gotB, DeepEquals, b)
+
+		shapes[string(shape)] = struct{}{}
+	}
+
+	c.Check(len(shapes) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkInterleave(c *C) {
+	a := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	b := []int{9, 10, 11, 12, 13, 14, 15, 16}
+
+	for i := 0; i < c.N; i++ {
+		securerandom.Interleave(a, b)
+	}
+}
+
+func (*TestSuite) TestBlockShuffle(c *C) {
+	var err error
+
+	c.Check(securerandom.BlockShuffle([]int{1, 2, 3}, 0), NotNil)
+
+	s := []int{1, 2, 3}
+	c.Assert(securerandom.BlockShuffle(s, 3), IsNil)
+	c.Check(s, DeepEquals, []int{1, 2, 3})
+
+	const blockSize = 3
+
+	orders := make(map[string]struct{})
+
+	for i := 0; i < 100; i++ {
+		// blocks are {0,1,2} {10,11,12} {20,21,22} {30,31,32} {40}
+		s = []int{0, 1, 2, 10, 11, 12, 20, 21, 22, 30, 31, 32, 40}
+
+		err = securerandom.BlockShuffle(s, blockSize)
+		c.Assert(err, IsNil)
+		c.Assert(len(s), Equals, 13)
+
+		order := make([]byte, 0, 5)
+
+		for j := 0; j < len(s); {
+			start := s[j]
+			c.Assert(start%10, Equals, 0)
+
+			order = append(order, byte('0'+start/10))
+
+			// walk the block, which must still be in order
+			for k := 0; k < blockSize && j < len(s) && s[j]/10 == start/10; k++ {
+				c.Assert(s[j], Equals, start+k)
+				j++
+			}
+		}
+
+		c.Assert(len(order), Equals, 5)
+		orders[string(order)] = struct{}{}
+	}
+
+	c.Check(len(orders) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkBlockShuffle(c *C) {
+	s := make([]int, 64)
+
+	for i := 0; i < c.N; i++ {
+		securerandom.BlockShuffle(s, 4)
+	}
+}
+
+func (*TestSuite) TestShuffleTracked(c *C) {
+	var perm []int
+	var err error
+
+	orig := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	orders := make(map[string]struct{})
+
+	for i := 0; i < 50; i++ {
+		s := append([]string(nil), orig...)
+
+		perm, err = securerandom.ShuffleTracked(s)
+		c.Assert(err, IsNil)
+		c.Assert(len(perm), Equals, len(s))
+
+		for pos, old := range perm {
+			c.Assert(s[pos], Equals, orig[old])
+		}
+
+		var order string
+
+		for _, v := range s {
+			order += v
+		}
+
+		orders[order] = struct{}{}
+
+		c.Assert(securerandom.Unshuffle(s, perm), IsNil)
+		c.Assert(s, DeepEquals, orig)
+	}
+
+	c.Check(len(orders) > 1, Equals, true)
+}
+
+func (*TestSuite) TestUnshuffle(c *C) {
+	s := []int{10, 20, 30}
+
+	c.Check(securerandom.Unshuffle(s, []int{0, 1}), NotNil)
+	c.Check(securerandom.Unshuffle(s, []int{0, 1, 3}), NotNil)
+	c.Check(securerandom.Unshuffle(s, []int{0, 1, 1}), NotNil)
+	c.Check(securerandom.Unshuffle(s, []int{0, -1, 2}), NotNil)
+	c.Check(s, DeepEquals, []int{10, 20, 30})
+
+	// the element at position 0 came from position 2, and so on
+	c.Assert(securerandom.Unshuffle(s, []int{2, 0, 1}), IsNil)
+	c.Check(s, DeepEquals, []int{20, 30, 10})
+}
+
+func (*TestSuite) BenchmarkShuffleTracked(c *C) {
+	s := make([]int, 64)
+
+	for i := 0; i < c.N; i++ {
+		securerandom.ShuffleTracked(s)
+	}
+}
+
+func (*TestSuite) TestShuffledValues(c *C) {
+	var out []int
+	var err error
+
+	out, err = securerandom.ShuffledValues([]int{})
+	c.Assert(err, IsNil)
+	c.Check(len(out), Equals, 0)
+
+	values := []int{1, 2, 2, 3, 4, 5, 6, 7}
+	firsts := make(map[int]struct{})
+
+	for i := 0; i < 100; i++ {
+		out, err = securerandom.ShuffledValues(values)
+		c.Assert(err, IsNil)
+		c.Assert(len(out), Equals, len(values))
+
+		counts := make(map[int]int)
+
+		for _, v := range out {
+			counts[v]++
+		}
+
+		for _, v := range values {
+			counts[v]--
+		}
+
+		for v, n := range counts {
+			c.Assert(n, Equals, 0, Commentf("value %d", v))
+		}
+
+		firsts[out[0]] = struct{}{}
+	}
+
+	// the input must be left untouched
+	c.Check(values, DeepEquals, []int{1, 2, 2, 3, 4, 5, 6, 7})
+	c.Check(len(firsts) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkShuffledValues(c *C) {
+	values := make([]int, 64)
+
+	for i := 0; i < c.N; i++ {
+		securerandom.ShuffledValues(values)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"sort"
+)
+
+// PercentageSplit is a function that returns the specified number of
+// non-negative integers that sum to exactly 100. Each possible split is equally
+// likely, as it's drawn using the "stars and bars" method: parts-1 bars are
+// placed among 100+parts-1 slots, and the sizes of the gaps between them become
+// the parts. The number of parts must be at least 1.
+func PercentageSplit(parts int) ([]int, error) {
+	if parts < 1 {
+		return nil, errors.New("parts must be at least 1")
+	}
+
+	const total = 100
+
+	bars, err := sampleIndices(total+parts-1, parts-1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Ints(bars)
+
+	out := make([]int, parts)
+	prev := -1
+
+	for i, b := range bars {
+		out[i] = b - prev - 1
+		prev = b
+	}
+
+	out[parts-1] = total + parts - 1 - prev - 1
+
+	return out, nil
+}
+
+// RandomChunks is a function that splits total into a slice of randomly-sized
+// positive chunks that sum to total, each of which is at most maxChunk. This is
+// useful for testing chunked upload and download handling. The total must be
+// greater than zero, and maxChunk must be at least 1.
+func RandomChunks(total, maxChunk int) ([]int, error) {
+	if total < 1 {
+		return nil, errors.New("total must be greater than zero")
+	}
+
+	if maxChunk < 1 {
+		return nil, errors.New("maxChunk must be at least 1")
+	}
+
+	var chunks []int
+
+	for remaining := total; remaining > 0; {
+		max := maxChunk
+
+		if max > remaining {
+			max = remaining
+		}
+
+		n, err := intn(max)
+
+		if err != nil {
+			return nil, err
+		}
+
+		chunks = append(chunks, n+1)
+		remaining -= n + 1
+	}
+
+	return chunks, nil
+}
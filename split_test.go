@@ -0,0 +1,86 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestPercentageSplit(c *C) {
+	var p []int
+	var err error
+
+	_, err = securerandom.PercentageSplit(0)
+	c.Check(err, NotNil)
+
+	p, err = securerandom.PercentageSplit(1)
+	c.Assert(err, IsNil)
+	c.Check(p, DeepEquals, []int{100})
+
+	for _, parts := range []int{2, 3, 7, 100, 250} {
+		for i := 0; i < 50; i++ {
+			p, err = securerandom.PercentageSplit(parts)
+			c.Assert(err, IsNil)
+			c.Assert(len(p), Equals, parts)
+
+			var sum int
+
+			for _, v := range p {
+				c.Assert(v >= 0, Equals, true)
+				sum += v
+			}
+
+			c.Assert(sum, Equals, 100)
+		}
+	}
+}
+
+func (*TestSuite) BenchmarkPercentageSplit(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.PercentageSplit(5)
+	}
+}
+
+func (*TestSuite) TestRandomChunks(c *C) {
+	var chunks []int
+	var err error
+
+	_, err = securerandom.RandomChunks(0, 10)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.RandomChunks(10, 0)
+	c.Check(err, NotNil)
+
+	chunks, err = securerandom.RandomChunks(5, 1)
+	c.Assert(err, IsNil)
+	c.Check(chunks, DeepEquals, []int{1, 1, 1, 1, 1})
+
+	sizes := make(map[int]struct{})
+
+	for i := 0; i < 100; i++ {
+		chunks, err = securerandom.RandomChunks(1000, 64)
+		c.Assert(err, IsNil)
+
+		var sum int
+
+		for _, n := range chunks {
+			c.Assert(n > 0 && n <= 64, Equals, true)
+			sum += n
+			sizes[n] = struct{}{}
+		}
+
+		c.Assert(sum, Equals, 1000)
+	}
+
+	c.Check(len(sizes) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkRandomChunks(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomChunks(4096, 512)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "fmt"
+
+// StateMachine randomly walks a state machine, only ever following the
+// transitions it was built with. This keeps fuzzing on legal paths through the
+// machine. As it's never modified after being built, it's safe for concurrent
+// use by multiple goroutines.
+//
+// Use NewStateMachine to create one.
+type StateMachine[S comparable] struct {
+	transitions map[S][]S
+}
+
+// NewStateMachine is a function that returns a StateMachine that allows the
+// transitions provided, which map each state to its allowed successors. The
+// transitions are copied, so later changes to the map have no effect.
+func NewStateMachine[S comparable](transitions map[S][]S) *StateMachine[S] {
+	t := make(map[S][]S, len(transitions))
+
+	for from, to := range transitions {
+		t[from] = append([]S(nil), to...)
+	}
+
+	return &StateMachine[S]{transitions: t}
+}
+
+// Next is a method that returns a uniformly chosen allowed successor of the
+// current state. An error is returned if the current state has no outgoing
+// transitions.
+func (sm *StateMachine[S]) Next(current S) (S, error) {
+	to := sm.transitions[current]
+
+	if len(to) == 0 {
+		var zero S
+		return zero, fmt.Errorf("state %v has no outgoing transitions", current)
+	}
+
+	return pick(to)
+}
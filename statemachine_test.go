@@ -0,0 +1,76 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestStateMachine(c *C) {
+	var s string
+	var err error
+
+	transitions := map[string][]string{
+		"pending": {"running", "canceled"},
+		"running": {"done", "failed", "canceled"},
+		"failed":  {"pending"},
+	}
+
+	sm := securerandom.NewStateMachine(transitions)
+
+	// changes to the original map must have no effect
+	transitions["pending"][0] = "bogus"
+	transitions["done"] = []string{"bogus"}
+
+	_, err = sm.Next("done")
+	c.Check(err, NotNil)
+
+	_, err = sm.Next("unknown")
+	c.Check(err, NotNil)
+
+	const runs = 3000
+
+	counts := make(map[string]int)
+
+	for i := 0; i < runs; i++ {
+		s, err = sm.Next("running")
+		c.Assert(err, IsNil)
+		counts[s]++
+	}
+
+	c.Assert(len(counts), Equals, 3)
+
+	for _, next := range []string{"done", "failed", "canceled"} {
+		c.Check(counts[next] > 850 && counts[next] < 1150, Equals, true, Commentf("%s: %d", next, counts[next]))
+	}
+
+	// walk the machine until it reaches a terminal state
+	allowed := map[string]map[string]bool{
+		"pending": {"running": true, "canceled": true},
+		"running": {"done": true, "failed": true, "canceled": true},
+		"failed":  {"pending": true},
+	}
+
+	for i := 0; i < 100; i++ {
+		state := "pending"
+
+		for allowed[state] != nil {
+			s, err = sm.Next(state)
+			c.Assert(err, IsNil)
+			c.Assert(allowed[state][s], Equals, true, Commentf("%s -> %s", state, s))
+			state = s
+		}
+	}
+}
+
+func (*TestSuite) BenchmarkStateMachineNext(c *C) {
+	sm := securerandom.NewStateMachine(map[int][]int{0: {1, 2, 3}})
+
+	for i := 0; i < c.N; i++ {
+		sm.Next(0)
+	}
+}
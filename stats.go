@@ -0,0 +1,38 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+// ChiSquareUniform is a function that computes the chi-square goodness-of-fit
+// statistic for the observed counts against a uniform expectation, along with
+// the degrees of freedom (one less than the number of buckets). It's intended
+// to let you assert that your own derived generators are unbiased in tests, by
+// comparing the statistic against the critical value for the returned degrees
+// of freedom. If there are no buckets, or no observations, the statistic is 0.
+func ChiSquareUniform(counts []int) (statistic float64, dof int) {
+	if len(counts) == 0 {
+		return 0, 0
+	}
+
+	var total int
+
+	for _, n := range counts {
+		total += n
+	}
+
+	dof = len(counts) - 1
+
+	if total == 0 {
+		return 0, dof
+	}
+
+	expected := float64(total) / float64(len(counts))
+
+	for _, n := range counts {
+		d := float64(n) - expected
+		statistic += d * d / expected
+	}
+
+	return statistic, dof
+}
@@ -0,0 +1,52 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestChiSquareUniform(c *C) {
+	var stat float64
+	var dof int
+
+	stat, dof = securerandom.ChiSquareUniform(nil)
+	c.Check(stat, Equals, 0.0)
+	c.Check(dof, Equals, 0)
+
+	stat, dof = securerandom.ChiSquareUniform([]int{0, 0, 0})
+	c.Check(stat, Equals, 0.0)
+	c.Check(dof, Equals, 2)
+
+	stat, dof = securerandom.ChiSquareUniform([]int{25, 25, 25, 25})
+	c.Check(stat, Equals, 0.0)
+	c.Check(dof, Equals, 3)
+
+	// expected is 20 per bucket: (100 + 0 + 100) / 20
+	stat, dof = securerandom.ChiSquareUniform([]int{10, 20, 30})
+	c.Check(stat, Equals, 10.0)
+	c.Check(dof, Equals, 2)
+
+	// expected is 50 per bucket: (2500 + 2500) / 50
+	stat, dof = securerandom.ChiSquareUniform([]int{100, 0})
+	c.Check(stat, Equals, 100.0)
+	c.Check(dof, Equals, 1)
+
+	// a real sample should fit comfortably; 30.66 is the p=0.000001
+	// critical value for 3 degrees of freedom
+	counts := make([]int, 4)
+
+	for i := 0; i < 4000; i++ {
+		v, err := securerandom.Uint16()
+		c.Assert(err, IsNil)
+		counts[v%4]++
+	}
+
+	stat, dof = securerandom.ChiSquareUniform(counts)
+	c.Check(dof, Equals, 3)
+	c.Check(stat < 30.66, Equals, true, Commentf("statistic: %f", stat))
+}
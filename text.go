@@ -0,0 +1,138 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// RandomCase is a function that returns s with the case of each letter chosen
+// by a fair coin flip, leaving everything else unchanged. This is useful for
+// producing "sPoNgEbOb"-style variants for testing case-insensitive matching.
+func RandomCase(s string) (string, error) {
+	var bs BoolSource
+	var sb strings.Builder
+
+	sb.Grow(len(s))
+
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			upper, err := bs.Next()
+
+			if err != nil {
+				return "", err
+			}
+
+			if upper {
+				r = unicode.ToUpper(r)
+			} else {
+				r = unicode.ToLower(r)
+			}
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String(), nil
+}
+
+// Template is a function that returns format with each {{name}} placeholder
+// replaced by the result of calling the generator for that name in vars. This
+// lets you compose random values into a single string:
+//
+//	s, err := securerandom.Template("{{user}}@{{host}}", map[string]func() (string, error){
+//		"user": func() (string, error) { return securerandom.URLBase64OfBytes(6) },
+//		"host": securerandom.RandomHostname,
+//	})
+//
+// Each placeholder calls its generator separately, so a name that appears more
+// than once can be replaced by different values. An error is returned if a
+// placeholder isn't closed, has no generator in vars, or if a generator fails.
+func Template(format string, vars map[string]func() (string, error)) (string, error) {
+	var sb strings.Builder
+
+	for {
+		start := strings.Index(format, "{{")
+
+		if start < 0 {
+			sb.WriteString(format)
+			break
+		}
+
+		end := strings.Index(format[start+2:], "}}")
+
+		if end < 0 {
+			return "", errors.New("unclosed placeholder in format")
+		}
+
+		name := format[start+2 : start+2+end]
+		gen, ok := vars[name]
+
+		if !ok || gen == nil {
+			return "", fmt.Errorf("unknown placeholder %q", name)
+		}
+
+		v, err := gen()
+
+		if err != nil {
+			return "", fmt.Errorf("placeholder %q: %w", name, err)
+		}
+
+		sb.WriteString(format[:start])
+		sb.WriteString(v)
+
+		format = format[start+2+end+2:]
+	}
+
+	return sb.String(), nil
+}
+
+// RandomOrdinal is a function that returns a random number in the range
+// [1, max] formatted as an English ordinal, such as "1st", "12th", or "23rd".
+// The value of max must be at least 1.
+func RandomOrdinal(max int) (string, error) {
+	if max < 1 {
+		return "", errors.New("max must be at least 1")
+	}
+
+	n, err := intn(max)
+
+	if err != nil {
+		return "", err
+	}
+
+	return Ordinal(n + 1), nil
+}
+
+// Ordinal is a function that returns n formatted as an English ordinal. The
+// suffix is "st", "nd", or "rd" for numbers ending in 1, 2, or 3 respectively,
+// except for those ending in 11, 12, or 13, which like all others use "th".
+func Ordinal(n int) string {
+	// work with the magnitude, as -n overflows for the minimum int
+	abs := uint64(n)
+
+	if n < 0 {
+		abs = -abs
+	}
+
+	suffix := "th"
+
+	if abs%100 < 11 || abs%100 > 13 {
+		switch abs % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+
+	return strconv.Itoa(n) + suffix
+}
@@ -0,0 +1,127 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestRandomCase(c *C) {
+	var s string
+	var err error
+
+	s, err = securerandom.RandomCase("")
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "")
+
+	s, err = securerandom.RandomCase("123 - !?")
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "123 - !?")
+
+	const input = "The Quick Brown Fox, 42 times!"
+
+	variants := make(map[string]struct{})
+
+	for i := 0; i < 20; i++ {
+		s, err = securerandom.RandomCase(input)
+		c.Assert(err, IsNil)
+		c.Check(strings.ToLower(s), Equals, strings.ToLower(input))
+		variants[s] = struct{}{}
+	}
+
+	c.Check(len(variants) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkRandomCase(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomCase("The Quick Brown Fox")
+	}
+}
+
+func (*TestSuite) TestTemplate(c *C) {
+	var s string
+	var err error
+
+	var calls int
+
+	vars := map[string]func() (string, error){
+		"word": func() (string, error) { return securerandom.Sentence(1) },
+		"num": func() (string, error) {
+			calls++
+			return strings.Repeat("9", calls), nil
+		},
+		"fail": func() (string, error) { return "", errors.New("boom") },
+	}
+
+	s, err = securerandom.Template("no placeholders", vars)
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "no placeholders")
+
+	s, err = securerandom.Template("{{num}}-{{num}} and {{word}}!", vars)
+	c.Assert(err, IsNil)
+	c.Check(strings.HasPrefix(s, "9-99 and "), Equals, true, Commentf("output: %s", s))
+	c.Check(strings.HasSuffix(s, ".!"), Equals, true, Commentf("output: %s", s))
+	c.Check(strings.Contains(s, "{{"), Equals, false)
+	c.Check(strings.Contains(s, "}}"), Equals, false)
+
+	_, err = securerandom.Template("hello {{missing}}", vars)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.Template("hello {{num", vars)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.Template("hello {{fail}}", vars)
+	c.Check(err, NotNil)
+}
+
+func (*TestSuite) TestOrdinal(c *C) {
+	expected := []string{
+		"1st", "2nd", "3rd", "4th", "5th", "6th", "7th", "8th", "9th", "10th",
+		"11th", "12th", "13th", "14th", "15th", "16th", "17th", "18th", "19th", "20th",
+		"21st", "22nd", "23rd", "24th", "25th", "26th", "27th", "28th", "29th", "30th",
+	}
+
+	for i, want := range expected {
+		c.Check(securerandom.Ordinal(i+1), Equals, want)
+	}
+
+	c.Check(securerandom.Ordinal(0), Equals, "0th")
+	c.Check(securerandom.Ordinal(100), Equals, "100th")
+	c.Check(securerandom.Ordinal(101), Equals, "101st")
+	c.Check(securerandom.Ordinal(111), Equals, "111th")
+	c.Check(securerandom.Ordinal(112), Equals, "112th")
+	c.Check(securerandom.Ordinal(1013), Equals, "1013th")
+	c.Check(securerandom.Ordinal(1022), Equals, "1022nd")
+	c.Check(securerandom.Ordinal(1000003), Equals, "1000003rd")
+	c.Check(securerandom.Ordinal(-1), Equals, "-1st")
+	c.Check(securerandom.Ordinal(-11), Equals, "-11th")
+}
+
+func (*TestSuite) TestRandomOrdinal(c *C) {
+	var s string
+	var err error
+
+	_, err = securerandom.RandomOrdinal(0)
+	c.Check(err, NotNil)
+
+	s, err = securerandom.RandomOrdinal(1)
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "1st")
+
+	seen := make(map[string]struct{})
+
+	for i := 0; i < 500; i++ {
+		s, err = securerandom.RandomOrdinal(4)
+		c.Assert(err, IsNil)
+		seen[s] = struct{}{}
+	}
+
+	c.Check(seen, DeepEquals, map[string]struct{}{"1st": {}, "2nd": {}, "3rd": {}, "4th": {}})
+}
@@ -0,0 +1,219 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+// timezones are the IANA time zone names RandomTimezone chooses from.
+var timezones = []string{
+	"UTC",
+	"Africa/Cairo", "Africa/Johannesburg", "Africa/Lagos", "Africa/Nairobi",
+	"America/Anchorage", "America/Chicago", "America/Denver",
+	"America/Los_Angeles", "America/Mexico_City", "America/New_York",
+	"America/Sao_Paulo", "America/St_Johns", "America/Toronto",
+	"Asia/Dubai", "Asia/Hong_Kong", "Asia/Kathmandu", "Asia/Kolkata",
+	"Asia/Seoul", "Asia/Shanghai", "Asia/Singapore", "Asia/Tokyo",
+	"Australia/Adelaide", "Australia/Perth", "Australia/Sydney",
+	"Europe/Berlin", "Europe/Istanbul", "Europe/London", "Europe/Madrid",
+	"Europe/Moscow", "Europe/Paris",
+	"Pacific/Auckland", "Pacific/Chatham", "Pacific/Honolulu",
+}
+
+// RecentTimestamp is a function that returns a random Unix timestamp, in
+// seconds, between now-within and now. The window must not be negative.
+func RecentTimestamp(within time.Duration) (int64, error) {
+	offset, err := secondsWithin(within)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Now().Unix() - offset, nil
+}
+
+// FutureTimestamp is a function that returns a random Unix timestamp, in
+// seconds, between now and now+within. The window must not be negative.
+func FutureTimestamp(within time.Duration) (int64, error) {
+	offset, err := secondsWithin(within)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Now().Unix() + offset, nil
+}
+
+// secondsWithin is a function that returns a uniformly distributed number of
+// whole seconds in the closed interval [0, within].
+func secondsWithin(within time.Duration) (int64, error) {
+	if within < 0 {
+		return 0, errors.New("within must not be negative")
+	}
+
+	return int64n(int64(within/time.Second) + 1)
+}
+
+// RandomTimezone is a function that returns a random IANA time zone, loaded
+// using time.LoadLocation, from a bundled list of zone names. If a zone fails to
+// load, for example because the system's time zone database is missing it,
+// another zone is chosen instead. An error is only returned if none of the
+// zones can be loaded.
+func RandomTimezone() (*time.Location, error) {
+	candidates := append([]string(nil), timezones...)
+
+	for len(candidates) > 0 {
+		i, err := intn(len(candidates))
+
+		if err != nil {
+			return nil, err
+		}
+
+		loc, err := time.LoadLocation(candidates[i])
+
+		if err == nil {
+			return loc, nil
+		}
+
+		// remove the failed zone so it isn't tried again
+		candidates[i] = candidates[len(candidates)-1]
+		candidates = candidates[:len(candidates)-1]
+	}
+
+	return nil, errors.New("unable to load any time zone")
+}
+
+// BackoffSchedule is a function that returns a precomputed plan of jittered
+// retry delays, one for each of the attempts. It uses capped exponential
+// backoff with "full jitter": the delay before attempt i is drawn uniformly
+// from [0, min(max, base*2^i)]. Having the whole schedule upfront lets the
+// caller inspect it, or cap the total wait time, before starting. The number of
+// attempts must not be negative, base must be greater than zero, and max must
+// not be less than base.
+func BackoffSchedule(attempts int, base, max time.Duration) ([]time.Duration, error) {
+	if attempts < 0 {
+		return nil, errors.New("attempts must not be negative")
+	}
+
+	if base <= 0 {
+		return nil, errors.New("base must be greater than zero")
+	}
+
+	if max < base {
+		return nil, errors.New("max must not be less than base")
+	}
+
+	schedule := make([]time.Duration, attempts)
+	ceil := base
+
+	for i := range schedule {
+		d, err := durationUpTo(ceil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		schedule[i] = d
+
+		// double the ceiling, taking care not to overflow
+		if ceil > max/2 {
+			ceil = max
+		} else {
+			ceil *= 2
+		}
+	}
+
+	return schedule, nil
+}
+
+// durationUpTo is a function that returns a uniformly distributed duration in
+// the closed interval [0, d], which must not be negative.
+func durationUpTo(d time.Duration) (time.Duration, error) {
+	// the bound is inclusive, unless that would overflow
+	bound := int64(d)
+
+	if bound < math.MaxInt64 {
+		bound++
+	}
+
+	n, err := int64n(bound)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(n), nil
+}
+
+// NonOverlappingIntervals is a function that returns count sorted,
+// non-overlapping, time intervals that all fall within span of the current
+// time. Each interval is a [start, end] pair with a random length between 1ns
+// and maxLen, and the gaps between them are random. Intervals may touch, with
+// one ending at the same instant the next starts, but they never overlap.
+//
+// To guarantee they fit, span must be at least count*maxLen; otherwise an
+// error is returned. The value of count must not be negative, and maxLen must
+// be greater than zero.
+func NonOverlappingIntervals(count int, span, maxLen time.Duration) ([][2]time.Time, error) {
+	if count < 0 {
+		return nil, errors.New("count must not be negative")
+	}
+
+	if maxLen <= 0 {
+		return nil, errors.New("maxLen must be greater than zero")
+	}
+
+	if span < 0 || (count > 0 && time.Duration(count) > span/maxLen) {
+		return nil, errors.New("intervals can't fit in the span")
+	}
+
+	lengths := make([]time.Duration, count)
+	slack := span
+
+	for i := range lengths {
+		d, err := durationUpTo(maxLen - 1)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lengths[i] = d + 1
+		slack -= lengths[i]
+	}
+
+	// spread the unused time randomly before, between, and after the
+	// intervals by placing each interval's leading gap at a sorted random
+	// offset within the slack
+	offsets := make([]time.Duration, count)
+
+	for i := range offsets {
+		d, err := durationUpTo(slack)
+
+		if err != nil {
+			return nil, err
+		}
+
+		offsets[i] = d
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	base := time.Now()
+	intervals := make([][2]time.Time, count)
+
+	var used time.Duration
+
+	for i := range intervals {
+		start := base.Add(offsets[i] + used)
+		intervals[i] = [2]time.Time{start, start.Add(lengths[i])}
+		used += lengths[i]
+	}
+
+	return intervals, nil
+}
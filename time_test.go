@@ -0,0 +1,218 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"math"
+	"time"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestRecentTimestamp(c *C) {
+	var ts int64
+	var err error
+
+	_, err = securerandom.RecentTimestamp(-time.Second)
+	c.Check(err, NotNil)
+
+	const within = time.Hour
+
+	for i := 0; i < 100; i++ {
+		before := time.Now().Unix()
+		ts, err = securerandom.RecentTimestamp(within)
+		after := time.Now().Unix()
+
+		c.Assert(err, IsNil)
+		c.Check(ts <= after, Equals, true)
+		c.Check(ts >= before-int64(within/time.Second), Equals, true)
+	}
+}
+
+func (*TestSuite) TestRecentTimestampLongWindow(c *C) {
+	// a window longer than 68 years overflows an int32 of seconds
+	const within = 100 * 365 * 24 * time.Hour
+
+	for i := 0; i < 100; i++ {
+		ts, err := securerandom.RecentTimestamp(within)
+		c.Assert(err, IsNil)
+		c.Check(ts <= time.Now().Unix(), Equals, true)
+		c.Check(ts >= time.Now().Add(-within).Unix()-1, Equals, true)
+	}
+}
+
+func (*TestSuite) TestFutureTimestamp(c *C) {
+	var ts int64
+	var err error
+
+	_, err = securerandom.FutureTimestamp(-time.Second)
+	c.Check(err, NotNil)
+
+	const within = time.Hour
+
+	for i := 0; i < 100; i++ {
+		before := time.Now().Unix()
+		ts, err = securerandom.FutureTimestamp(within)
+		after := time.Now().Unix()
+
+		c.Assert(err, IsNil)
+		c.Check(ts >= before, Equals, true)
+		c.Check(ts <= after+int64(within/time.Second), Equals, true)
+	}
+}
+
+func (*TestSuite) BenchmarkRecentTimestamp(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RecentTimestamp(time.Hour)
+	}
+}
+
+func (*TestSuite) TestRandomTimezone(c *C) {
+	var loc *time.Location
+	var err error
+
+	seen := make(map[string]struct{})
+
+	for i := 0; i < 50; i++ {
+		loc, err = securerandom.RandomTimezone()
+		c.Assert(err, IsNil)
+		c.Assert(loc, NotNil)
+		seen[loc.String()] = struct{}{}
+	}
+
+	c.Check(len(seen) > 1, Equals, true)
+}
+
+func (*TestSuite) BenchmarkRandomTimezone(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomTimezone()
+	}
+}
+
+func (*TestSuite) TestBackoffSchedule(c *C) {
+	var schedule []time.Duration
+	var err error
+
+	_, err = securerandom.BackoffSchedule(-1, time.Second, time.Minute)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.BackoffSchedule(3, 0, time.Minute)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.BackoffSchedule(3, time.Minute, time.Second)
+	c.Check(err, NotNil)
+
+	schedule, err = securerandom.BackoffSchedule(0, time.Second, time.Minute)
+	c.Assert(err, IsNil)
+	c.Check(len(schedule), Equals, 0)
+
+	const attempts = 8
+	const runs = 2000
+	const base = 100 * time.Millisecond
+	const max = 2 * time.Second
+
+	// the ceilings double from base until they saturate at max
+	ceilings := []time.Duration{
+		100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		800 * time.Millisecond, 1600 * time.Millisecond, max, max, max,
+	}
+
+	sums := make([]time.Duration, attempts)
+
+	for i := 0; i < runs; i++ {
+		schedule, err = securerandom.BackoffSchedule(attempts, base, max)
+		c.Assert(err, IsNil)
+		c.Assert(len(schedule), Equals, attempts)
+
+		for j, d := range schedule {
+			c.Assert(d >= 0 && d <= ceilings[j], Equals, true, Commentf("attempt %d: %s", j, d))
+			sums[j] += d
+		}
+	}
+
+	// with full jitter each delay averages half of its ceiling
+	for j, sum := range sums {
+		mean := float64(sum) / runs
+		expected := float64(ceilings[j]) / 2
+		c.Check(mean > expected*0.9 && mean < expected*1.1, Equals, true, Commentf("attempt %d: mean %s, expected %s", j, time.Duration(mean), time.Duration(expected)))
+	}
+
+	// the ceiling must never overflow for large attempt counts
+	schedule, err = securerandom.BackoffSchedule(100, time.Second, time.Duration(math.MaxInt64))
+	c.Assert(err, IsNil)
+
+	for _, d := range schedule {
+		c.Assert(d >= 0, Equals, true)
+	}
+}
+
+func (*TestSuite) BenchmarkBackoffSchedule(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.BackoffSchedule(10, time.Millisecond, time.Second)
+	}
+}
+
+func (*TestSuite) TestNonOverlappingIntervals(c *C) {
+	var intervals [][2]time.Time
+	var err error
+
+	_, err = securerandom.NonOverlappingIntervals(-1, time.Hour, time.Minute)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.NonOverlappingIntervals(3, time.Hour, 0)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.NonOverlappingIntervals(61, time.Hour, time.Minute)
+	c.Check(err, NotNil)
+
+	intervals, err = securerandom.NonOverlappingIntervals(0, time.Hour, time.Minute)
+	c.Assert(err, IsNil)
+	c.Check(len(intervals), Equals, 0)
+
+	const span = 8 * time.Hour
+	const maxLen = 30 * time.Minute
+
+	for _, count := range []int{1, 5, 16} {
+		for i := 0; i < 50; i++ {
+			before := time.Now()
+			intervals, err = securerandom.NonOverlappingIntervals(count, span, maxLen)
+			after := time.Now()
+
+			c.Assert(err, IsNil)
+			c.Assert(len(intervals), Equals, count)
+
+			for j, iv := range intervals {
+				length := iv[1].Sub(iv[0])
+				c.Assert(length > 0 && length <= maxLen, Equals, true, Commentf("length: %s", length))
+
+				c.Assert(iv[0].Before(before), Equals, false)
+				c.Assert(iv[1].After(after.Add(span)), Equals, false)
+
+				if j > 0 {
+					c.Assert(iv[0].Before(intervals[j-1][1]), Equals, false, Commentf("interval %d overlaps the one before it", j))
+				}
+			}
+		}
+	}
+
+	// spans and lengths wider than an int32 of nanoseconds must work on
+	// 32-bit platforms too
+	intervals, err = securerandom.NonOverlappingIntervals(2, 24*time.Hour, time.Hour)
+	c.Assert(err, IsNil)
+	c.Check(len(intervals), Equals, 2)
+
+	// intervals that exactly fill the span must still fit
+	intervals, err = securerandom.NonOverlappingIntervals(4, 4*time.Second, time.Second)
+	c.Assert(err, IsNil)
+	c.Check(len(intervals), Equals, 4)
+}
+
+func (*TestSuite) BenchmarkNonOverlappingIntervals(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.NonOverlappingIntervals(8, time.Hour, time.Minute)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// base36Chars are the digits used for base36 tokens.
+	base36Chars = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// segmentLength is the length of each segment of a HierarchicalID.
+	segmentLength = 6
+)
+
+// RandomJWTLike is a function that returns a string shaped like a JSON Web
+// Token: three unpadded base64url segments in the form of
+// "header.payload.signature". The header and payload decode to random JSON
+// objects, but the signature is just random bytes.
+//
+// The token is NOT signed and must only be used for testing code that inspects
+// the shape of a token. It should never be accepted by anything that verifies
+// signatures.
+func RandomJWTLike() (string, error) {
+	alg, err := pick([]string{"HS256", "RS256", "ES256"})
+
+	if err != nil {
+		return "", err
+	}
+
+	kid, err := URLBase64OfBytes(9)
+
+	if err != nil {
+		return "", err
+	}
+
+	sub, err := Uint32()
+
+	if err != nil {
+		return "", err
+	}
+
+	jti, err := URLBase64OfBytes(12)
+
+	if err != nil {
+		return "", err
+	}
+
+	ttl, err := Uint16()
+
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().Unix()
+
+	header, err := json.Marshal(map[string]interface{}{
+		"alg": alg,
+		"typ": "JWT",
+		"kid": kid,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"sub": sub,
+		"jti": jti,
+		"iat": now,
+		"exp": now + int64(ttl),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := Bytes(32)
+
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+
+	return enc.EncodeToString(header) + "." + enc.EncodeToString(payload) + "." + enc.EncodeToString(sig), nil
+}
+
+// HierarchicalID is a function that returns a path-like ID, such as
+// "k3x9qa/0pz1mb/7hd2lc", made of the number of levels specified joined by sep.
+// Each level is a 6-character base36 token. This is useful for producing
+// realistic nested keys for object-store tests. The number of levels must be
+// at least 1, and sep must not be empty or contain any base36 characters
+// ([0-9a-z]), so that splitting the ID on sep always yields exactly levels
+// segments.
+func HierarchicalID(levels int, sep string) (string, error) {
+	if levels < 1 {
+		return "", errors.New("levels must be at least 1")
+	}
+
+	if sep == "" {
+		return "", errors.New("sep must not be empty")
+	}
+
+	if strings.ContainsAny(sep, base36Chars) {
+		return "", errors.New("sep must not contain base36 characters")
+	}
+
+	segments := make([]string, levels)
+
+	for i := range segments {
+		b := make([]byte, segmentLength)
+
+		for j := range b {
+			n, err := intn(len(base36Chars))
+
+			if err != nil {
+				return "", err
+			}
+
+			b[j] = base36Chars[n]
+		}
+
+		segments[i] = string(b)
+	}
+
+	return strings.Join(segments, sep), nil
+}
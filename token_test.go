@@ -0,0 +1,91 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestRandomJWTLike(c *C) {
+	var s string
+	var err error
+
+	s, err = securerandom.RandomJWTLike()
+	c.Assert(err, IsNil)
+
+	segments := strings.Split(s, ".")
+	c.Assert(len(segments), Equals, 3)
+
+	for i, seg := range segments {
+		var b []byte
+
+		b, err = base64.RawURLEncoding.DecodeString(seg)
+		c.Assert(err, IsNil)
+
+		// only the header and payload are JSON
+		if i < 2 {
+			var obj map[string]interface{}
+			c.Check(json.Unmarshal(b, &obj), IsNil)
+		}
+	}
+
+	var other string
+
+	other, err = securerandom.RandomJWTLike()
+	c.Assert(err, IsNil)
+	c.Check(other, Not(Equals), s)
+}
+
+func (*TestSuite) BenchmarkRandomJWTLike(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.RandomJWTLike()
+	}
+}
+
+func (*TestSuite) TestHierarchicalID(c *C) {
+	var s string
+	var err error
+
+	_, err = securerandom.HierarchicalID(0, "/")
+	c.Check(err, NotNil)
+
+	_, err = securerandom.HierarchicalID(3, "")
+	c.Check(err, NotNil)
+
+	_, err = securerandom.HierarchicalID(3, "a")
+	c.Check(err, NotNil)
+
+	_, err = securerandom.HierarchicalID(3, "-0-")
+	c.Check(err, NotNil)
+
+	segment := regexp.MustCompile(`^[0-9a-z]{6}$`)
+
+	for _, levels := range []int{1, 2, 5} {
+		for _, sep := range []string{"/", "::"} {
+			s, err = securerandom.HierarchicalID(levels, sep)
+			c.Assert(err, IsNil)
+
+			parts := strings.Split(s, sep)
+			c.Check(len(parts), Equals, levels, Commentf("id: %s", s))
+
+			for _, p := range parts {
+				c.Check(segment.MatchString(p), Equals, true, Commentf("segment: %s", p))
+			}
+		}
+	}
+}
+
+func (*TestSuite) BenchmarkHierarchicalID(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.HierarchicalID(3, "/")
+	}
+}
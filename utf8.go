@@ -0,0 +1,90 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// surrogateMin is the first UTF-16 surrogate code point, which isn't a
+	// valid Unicode scalar value.
+	surrogateMin = 0xD800
+
+	// surrogateCount is the number of UTF-16 surrogate code points.
+	surrogateCount = 0x800
+
+	// scalarCount is the number of valid Unicode scalar values.
+	scalarCount = utf8.MaxRune + 1 - surrogateCount
+)
+
+// ValidUTF8String is a function that returns a string of n random Unicode
+// scalar values, correctly encoded as UTF-8. Each rune is uniformly chosen from
+// all of the code points except the UTF-16 surrogates, which can't be encoded.
+// As most runes are multi-byte, the length of the string in bytes will exceed
+// n, but the rune count will be exactly n.
+func ValidUTF8String(n int) (string, error) {
+	if n < 0 {
+		return "", errors.New("n must not be negative")
+	}
+
+	var sb strings.Builder
+	sb.Grow(n * utf8.UTFMax)
+
+	for i := 0; i < n; i++ {
+		r, err := intn(scalarCount)
+
+		if err != nil {
+			return "", err
+		}
+
+		// skip over the surrogate range
+		if r >= surrogateMin {
+			r += surrogateCount
+		}
+
+		sb.WriteRune(rune(r))
+	}
+
+	return sb.String(), nil
+}
+
+// neverValidUTF8 are the byte values that can't appear anywhere in valid UTF-8.
+var neverValidUTF8 = []byte{0xC0, 0xC1, 0xF5, 0xF6, 0xF7, 0xF8, 0xF9, 0xFA, 0xFB, 0xFC, 0xFD, 0xFE, 0xFF}
+
+// InvalidUTF8Bytes is a function that returns a slice of at least n random
+// bytes that is guaranteed to not be valid UTF-8. This is useful for testing
+// that code rejects bad input. The invalidity is guaranteed by placing, at a
+// random position, a byte value that can never appear in valid UTF-8. If n is
+// zero the slice will contain a single byte.
+func InvalidUTF8Bytes(n int) ([]byte, error) {
+	if n < 0 {
+		return nil, errors.New("n must not be negative")
+	}
+
+	if n == 0 {
+		n = 1
+	}
+
+	b, err := Bytes(n)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pos, err := intn(n)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if b[pos], err = pick(neverValidUTF8); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
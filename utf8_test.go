@@ -0,0 +1,66 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"unicode/utf8"
+
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestValidUTF8String(c *C) {
+	var s string
+	var err error
+
+	_, err = securerandom.ValidUTF8String(-1)
+	c.Check(err, NotNil)
+
+	s, err = securerandom.ValidUTF8String(0)
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "")
+
+	for _, n := range []int{1, 16, 1024} {
+		s, err = securerandom.ValidUTF8String(n)
+		c.Assert(err, IsNil)
+		c.Check(utf8.ValidString(s), Equals, true)
+		c.Check(utf8.RuneCountInString(s), Equals, n)
+
+		for _, r := range s {
+			c.Assert(r < 0xD800 || r > 0xDFFF, Equals, true)
+		}
+	}
+}
+
+func (*TestSuite) BenchmarkValidUTF8String(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.ValidUTF8String(32)
+	}
+}
+
+func (*TestSuite) TestInvalidUTF8Bytes(c *C) {
+	var b []byte
+	var err error
+
+	_, err = securerandom.InvalidUTF8Bytes(-1)
+	c.Check(err, NotNil)
+
+	for _, n := range []int{0, 1, 2, 16, 1024} {
+		for i := 0; i < 50; i++ {
+			b, err = securerandom.InvalidUTF8Bytes(n)
+			c.Assert(err, IsNil)
+			c.Assert(len(b) >= n, Equals, true)
+			c.Assert(utf8.Valid(b), Equals, false)
+		}
+	}
+}
+
+func (*TestSuite) BenchmarkInvalidUTF8Bytes(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.InvalidUTF8Bytes(32)
+		c.SetBytes(32)
+	}
+}
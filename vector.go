@@ -0,0 +1,42 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import "errors"
+
+// SparseVector is a function that returns a random sparse vector with dim
+// dimensions, as a map from index to value. Each index in [0, dim) is present
+// with probability density, and the values are uniformly distributed in
+// [0.0, 1.0). The value of dim must not be negative, and density must be in the
+// range [0, 1].
+func SparseVector(dim int, density float64) (map[int]float64, error) {
+	if dim < 0 {
+		return nil, errors.New("dim must not be negative")
+	}
+
+	if !(density >= 0 && density <= 1) {
+		return nil, errors.New("density must be in the range [0, 1]")
+	}
+
+	v := make(map[int]float64, int(float64(dim)*density))
+
+	for i := 0; i < dim; i++ {
+		f, err := float64n()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if f >= density {
+			continue
+		}
+
+		if v[i], err = float64n(); err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
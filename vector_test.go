@@ -0,0 +1,52 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestSparseVector(c *C) {
+	var v map[int]float64
+	var err error
+
+	_, err = securerandom.SparseVector(-1, 0.5)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.SparseVector(10, -0.1)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.SparseVector(10, 1.1)
+	c.Check(err, NotNil)
+
+	v, err = securerandom.SparseVector(100, 0)
+	c.Assert(err, IsNil)
+	c.Check(len(v), Equals, 0)
+
+	v, err = securerandom.SparseVector(100, 1)
+	c.Assert(err, IsNil)
+	c.Check(len(v), Equals, 100)
+
+	const dim = 10000
+
+	v, err = securerandom.SparseVector(dim, 0.1)
+	c.Assert(err, IsNil)
+
+	// expecting 1000 entries, with a standard deviation of 30
+	c.Check(len(v) > 850 && len(v) < 1150, Equals, true, Commentf("entries: %d", len(v)))
+
+	for i, f := range v {
+		c.Assert(i >= 0 && i < dim, Equals, true)
+		c.Assert(f >= 0 && f < 1, Equals, true)
+	}
+}
+
+func (*TestSuite) BenchmarkSparseVector(c *C) {
+	for i := 0; i < c.N; i++ {
+		securerandom.SparseVector(256, 0.1)
+	}
+}
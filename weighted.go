@@ -0,0 +1,199 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+)
+
+// WeightedShuffle is a function that returns a weighted random ordering of all
+// of the items provided. Each item is assigned the key u^(1/w), where u is a
+// uniform random value and w is the item's weight, and the items are then
+// sorted by that key in descending order. This is the Efraimidis-Spirakis
+// algorithm, and it means heavier items tend to appear earlier in the result.
+// The input slice is not modified. Each weight must be positive, and there must
+// be exactly one weight per item.
+func WeightedShuffle[T any](items []T, weights []float64) ([]T, error) {
+	if len(items) != len(weights) {
+		return nil, errors.New("items and weights must be the same length")
+	}
+
+	for _, w := range weights {
+		if !(w > 0) || math.IsInf(w, 1) {
+			return nil, errors.New("weights must be positive and finite")
+		}
+	}
+
+	keys := make([]float64, len(items))
+	idx := make([]int, len(items))
+
+	for i, w := range weights {
+		u, err := float64n()
+
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = math.Pow(u, 1/w)
+		idx[i] = i
+	}
+
+	sort.SliceStable(idx, func(a, b int) bool { return keys[idx[a]] > keys[idx[b]] })
+
+	out := make([]T, len(items))
+
+	for i, j := range idx {
+		out[i] = items[j]
+	}
+
+	return out, nil
+}
+
+// ChoiceByWeight is a function that returns one of the items, chosen with
+// probability proportional to its weight as returned by the weight function.
+// This saves building a parallel slice of weights when the weight is a field of
+// the items. There must be at least one item, each weight must be non-negative
+// and finite, and at least one weight must be positive.
+func ChoiceByWeight[T any](items []T, weight func(T) float64) (T, error) {
+	var zero T
+
+	if len(items) == 0 {
+		return zero, errors.New("items must not be empty")
+	}
+
+	if weight == nil {
+		return zero, errors.New("weight must not be nil")
+	}
+
+	weights := make([]float64, len(items))
+
+	var total float64
+
+	for i, item := range items {
+		w := weight(item)
+
+		if !(w >= 0) || math.IsInf(w, 1) {
+			return zero, errors.New("weights must be non-negative and finite")
+		}
+
+		weights[i] = w
+		total += w
+	}
+
+	if !(total > 0) || math.IsInf(total, 1) {
+		return zero, errors.New("total weight must be positive and finite")
+	}
+
+	f, err := float64n()
+
+	if err != nil {
+		return zero, err
+	}
+
+	target := f * total
+	last := 0
+
+	for i, w := range weights {
+		if w == 0 {
+			continue
+		}
+
+		if target < w {
+			return items[i], nil
+		}
+
+		target -= w
+		last = i
+	}
+
+	// floating point error can leave a sliver of target remaining, in
+	// which case the last item with any weight is the right choice
+	return items[last], nil
+}
+
+// AdaptivePicker is a weighted picker whose weights change over time. Every
+// item starts with the same weight, and each call to Reward increases the
+// weight of an item so that it's more likely to be picked in the future. This
+// is useful for simulating access patterns that drift, or feedback loops. It's
+// safe for concurrent use by multiple goroutines.
+//
+// Use NewAdaptivePicker to create one.
+type AdaptivePicker[T comparable] struct {
+	mu      sync.Mutex
+	items   []T
+	weights []int
+	index   map[T]int
+	total   int
+}
+
+// NewAdaptivePicker is a function that returns an AdaptivePicker that starts
+// out choosing uniformly from the items provided. Duplicate items are only
+// counted once. There must be at least one item.
+func NewAdaptivePicker[T comparable](items []T) (*AdaptivePicker[T], error) {
+	if len(items) == 0 {
+		return nil, errors.New("items must not be empty")
+	}
+
+	ap := &AdaptivePicker[T]{index: make(map[T]int, len(items))}
+
+	for _, item := range items {
+		if _, ok := ap.index[item]; ok {
+			continue
+		}
+
+		ap.index[item] = len(ap.items)
+		ap.items = append(ap.items, item)
+		ap.weights = append(ap.weights, 1)
+		ap.total++
+	}
+
+	return ap, nil
+}
+
+// Reward is a method that increases the selection weight of item by one. An
+// error is returned if the item isn't known to the picker.
+func (ap *AdaptivePicker[T]) Reward(item T) error {
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+
+	i, ok := ap.index[item]
+
+	if !ok {
+		return errors.New("item is not known to the picker")
+	}
+
+	ap.weights[i]++
+	ap.total++
+
+	return nil
+}
+
+// Pick is a method that returns an item chosen with probability proportional
+// to its current weight.
+func (ap *AdaptivePicker[T]) Pick() (T, error) {
+	ap.mu.Lock()
+	defer ap.mu.Unlock()
+
+	n, err := intn(ap.total)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	for i, w := range ap.weights {
+		if n < w {
+			return ap.items[i], nil
+		}
+
+		n -= w
+	}
+
+	// unreachable, as n is always less than the total weight
+	return ap.items[len(ap.items)-1], nil
+}
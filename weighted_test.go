@@ -0,0 +1,159 @@
+// Copyright 2016 Tim Heckman. All rights reserved.
+// Use of this source code is governed by the BSD 3-Clause
+// license that can be found in the LICENSE file.
+
+package securerandom_test
+
+import (
+	"github.com/theckman/go-securerandom"
+
+	. "gopkg.in/check.v1"
+)
+
+func (*TestSuite) TestWeightedShuffle(c *C) {
+	var out []string
+	var err error
+
+	_, err = securerandom.WeightedShuffle([]string{"a", "b"}, []float64{1})
+	c.Check(err, NotNil)
+
+	_, err = securerandom.WeightedShuffle([]string{"a", "b"}, []float64{1, 0})
+	c.Check(err, NotNil)
+
+	_, err = securerandom.WeightedShuffle([]string{"a", "b"}, []float64{1, -1})
+	c.Check(err, NotNil)
+
+	items := []string{"light", "medium", "heavy"}
+	weights := []float64{1, 5, 25}
+	positions := make(map[string]int)
+
+	const runs = 2000
+
+	for i := 0; i < runs; i++ {
+		out, err = securerandom.WeightedShuffle(items, weights)
+		c.Assert(err, IsNil)
+		c.Assert(len(out), Equals, len(items))
+
+		for pos, item := range out {
+			positions[item] += pos
+		}
+	}
+
+	// the input must be left untouched
+	c.Check(items, DeepEquals, []string{"light", "medium", "heavy"})
+
+	// heavier items should, on average, be positioned earlier
+	c.Check(positions["heavy"] < positions["medium"], Equals, true)
+	c.Check(positions["medium"] < positions["light"], Equals, true)
+}
+
+func (*TestSuite) BenchmarkWeightedShuffle(c *C) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	weights := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	for i := 0; i < c.N; i++ {
+		securerandom.WeightedShuffle(items, weights)
+	}
+}
+
+type weightedItem struct {
+	name   string
+	weight float64
+}
+
+func (*TestSuite) TestChoiceByWeight(c *C) {
+	var v weightedItem
+	var err error
+
+	weight := func(i weightedItem) float64 { return i.weight }
+
+	_, err = securerandom.ChoiceByWeight([]weightedItem{}, weight)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.ChoiceByWeight([]weightedItem{{"a", 1}}, nil)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.ChoiceByWeight([]weightedItem{{"a", 1}, {"b", -1}}, weight)
+	c.Check(err, NotNil)
+
+	_, err = securerandom.ChoiceByWeight([]weightedItem{{"a", 0}, {"b", 0}}, weight)
+	c.Check(err, NotNil)
+
+	items := []weightedItem{{"a", 1}, {"never", 0}, {"b", 2}, {"c", 5}}
+
+	const runs = 8000
+
+	counts := make(map[string]int)
+
+	for i := 0; i < runs; i++ {
+		v, err = securerandom.ChoiceByWeight(items, weight)
+		c.Assert(err, IsNil)
+		counts[v.name]++
+	}
+
+	c.Check(counts["never"], Equals, 0)
+
+	// expecting 1000, 2000, and 5000
+	for _, item := range items {
+		expected := runs * item.weight / 8
+		got := float64(counts[item.name])
+		c.Check(got >= expected*0.85 && got <= expected*1.15, Equals, true, Commentf("%s: got %v, expected %v", item.name, got, expected))
+	}
+}
+
+func (*TestSuite) BenchmarkChoiceByWeight(c *C) {
+	items := []weightedItem{{"a", 1}, {"b", 2}, {"c", 5}}
+	weight := func(i weightedItem) float64 { return i.weight }
+
+	for i := 0; i < c.N; i++ {
+		securerandom.ChoiceByWeight(items, weight)
+	}
+}
+
+func (*TestSuite) TestAdaptivePicker(c *C) {
+	var ap *securerandom.AdaptivePicker[string]
+	var v string
+	var err error
+
+	_, err = securerandom.NewAdaptivePicker([]string{})
+	c.Check(err, NotNil)
+
+	ap, err = securerandom.NewAdaptivePicker([]string{"a", "b", "c", "a"})
+	c.Assert(err, IsNil)
+
+	c.Check(ap.Reward("z"), NotNil)
+
+	// before any rewards, every item should be reachable
+	seen := make(map[string]int)
+
+	for i := 0; i < 300; i++ {
+		v, err = ap.Pick()
+		c.Assert(err, IsNil)
+		seen[v]++
+	}
+
+	c.Check(len(seen), Equals, 3)
+
+	for i := 0; i < 1000; i++ {
+		c.Assert(ap.Reward("b"), IsNil)
+	}
+
+	// "b" now holds 1001 of the 1003 total weight
+	seen = make(map[string]int)
+
+	for i := 0; i < 1000; i++ {
+		v, err = ap.Pick()
+		c.Assert(err, IsNil)
+		seen[v]++
+	}
+
+	c.Check(seen["b"] > 950, Equals, true, Commentf("picks: %v", seen))
+}
+
+func (*TestSuite) BenchmarkAdaptivePickerPick(c *C) {
+	ap, _ := securerandom.NewAdaptivePicker([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	for i := 0; i < c.N; i++ {
+		ap.Pick()
+	}
+}